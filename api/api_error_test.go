@@ -0,0 +1,64 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestAPIErrorStatusMatching tests the typed error returned for non-2xx responses
+//
+// Acceptance Criteria:
+// - A non-2xx response returns an *APIError carrying the status code and body
+// - 401, 403, 404, and 429 match ErrUnauthorized, ErrForbidden, ErrNotFound, and ErrRateLimited
+// - Any 5xx matches ErrServer
+// - The status code still appears in the error string
+// - Matching survives wrapping with %w
+func TestAPIErrorStatusMatching(t *testing.T) {
+	cases := []struct {
+		status   int
+		sentinel error
+	}{
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusServiceUnavailable, ErrServer},
+	}
+	sentinels := []error{ErrUnauthorized, ErrForbidden, ErrNotFound, ErrRateLimited, ErrServer}
+
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("Given a %d response When requesting Then only %v matches", tc.status, tc.sentinel), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				w.Write([]byte(" {\"error\": \"nope\"}\n"))
+			}))
+			defer server.Close()
+
+			client := NewClient("test-key")
+			client.SetMaxRetries(0)
+			_, err := client.doRequestWithoutBase(context.Background(), "GET", server.URL, nil)
+			wrapped := fmt.Errorf("failed to fetch: %w", err)
+
+			var apiErr *APIError
+			if !errors.As(wrapped, &apiErr) {
+				t.Fatalf("Expected an *APIError, got %T: %v", err, err)
+			}
+			if apiErr.StatusCode != tc.status || apiErr.Body != `{"error": "nope"}` {
+				t.Errorf("Unexpected status or body: %d %q", apiErr.StatusCode, apiErr.Body)
+			}
+			if !strings.Contains(err.Error(), fmt.Sprint(tc.status)) {
+				t.Errorf("Expected the status code in %q", err.Error())
+			}
+			for _, sentinel := range sentinels {
+				if got := errors.Is(wrapped, sentinel); got != (sentinel == tc.sentinel) {
+					t.Errorf("errors.Is(%v) = %v", sentinel, got)
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -154,7 +155,7 @@ func testFilterParameter(client *Client, userID, paramName, paramValue string) *
 		RequestURL:     path,
 	}
 
-	response, err := client.doRequest(httpMethodGET, path, nil)
+	response, err := client.doRequest(context.Background(), httpMethodGET, path, nil)
 	if err != nil {
 		result.ErrorMessage = err.Error()
 		result.IsAccepted = false
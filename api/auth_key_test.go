@@ -0,0 +1,48 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestEmptyAuthKeyPreflight tests that a client without an auth key fails before sending a request
+func TestEmptyAuthKeyPreflight(t *testing.T) {
+	tests := []struct {
+		name    string
+		authKey string
+	}{
+		{"Given an empty auth key When making a request Then a descriptive error is returned without a network call", ""},
+		{"Given a whitespace-only auth key When making a request Then a descriptive error is returned without a network call", "   "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestCount := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestCount++
+				w.WriteHeader(http.StatusUnauthorized)
+			}))
+			defer server.Close()
+
+			client := NewClient(tt.authKey)
+			client.baseURL = server.URL
+
+			_, err := client.GetBins()
+
+			if err == nil {
+				t.Fatal("Expected error for empty auth key, got nil")
+			}
+			if !strings.Contains(err.Error(), "empty auth key") {
+				t.Errorf("Expected descriptive empty auth key error, got: %v", err)
+			}
+			if strings.Contains(err.Error(), "401") {
+				t.Errorf("Expected pre-flight error rather than a 401, got: %v", err)
+			}
+			if requestCount != 0 {
+				t.Errorf("Expected no requests to reach the server, got %d", requestCount)
+			}
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package api
 
 import (
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -17,7 +19,7 @@ import (
 // - Matching is case-insensitive
 // - Returns error if bin name not found
 // - Handles bins with special characters in names
-// - Returns first match if multiple bins have same name
+// - Returns an error listing every matching ID if multiple bins have the same name
 func TestLookupBinIDByName(t *testing.T) {
 	t.Run("Given bin name When looking up ID Then return matching bin ID", func(t *testing.T) {
 		// Arrange
@@ -144,4 +146,34 @@ func TestLookupBinIDByName(t *testing.T) {
 			t.Errorf("Expected empty bin ID on error, got %s", binID)
 		}
 	})
+
+	t.Run("Given two bins sharing a name When looking up ID Then return an error listing both IDs", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`[
+				{"_id": "binA", "name": "Doing"},
+				{"_id": "bin123", "name": "In Progress"},
+				{"_id": "binB", "name": "doing"}
+			]`))
+		}))
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+
+		// Act
+		binID, err := client.LookupBinIDByName("Doing")
+
+		// Assert
+		if !errors.Is(err, ErrAmbiguousBinName) {
+			t.Fatalf("Expected ErrAmbiguousBinName, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "binA, binB") {
+			t.Errorf("Expected both matching IDs in the error, got %v", err)
+		}
+		if binID != "" {
+			t.Errorf("Expected empty bin ID on error, got %s", binID)
+		}
+	})
 }
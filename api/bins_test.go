@@ -104,6 +104,7 @@ func TestGetBins(t *testing.T) {
 
 		client := NewClient("test-key")
 		client.baseURL = server.URL
+		client.SetMaxRetries(0)
 
 		// Act
 		bins, err := client.GetBins()
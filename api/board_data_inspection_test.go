@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -129,7 +130,7 @@ func TestInspectAPIResponseForBoardData(t *testing.T) {
 // captureRawTicketSearchResponse captures the raw JSON response from ticket search
 func captureRawTicketSearchResponse(client *Client, userID string) ([]byte, error) {
 	path := buildTicketSearchPath([]string{userID})
-	response, err := client.doRequest(httpMethodGET, path, nil)
+	response, err := client.doRequest(context.Background(), httpMethodGET, path, nil)
 	if err != nil {
 		return nil, err
 	}
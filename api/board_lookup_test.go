@@ -1,6 +1,7 @@
 package api
 
 import (
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -17,6 +18,7 @@ import (
 // - Given a board name, return the corresponding board ID
 // - Matching is case-insensitive
 // - Returns error if board name not found
+// - A name shared by several boards is ErrAmbiguousBoardName listing every ID, not the first match
 // - Handles boards with special characters in names
 // - Can use board ID to filter tickets via server-side filtering
 func TestLookupBoardIDByName(t *testing.T) {
@@ -119,6 +121,35 @@ func TestLookupBoardIDByName(t *testing.T) {
 			t.Errorf("Expected empty board ID on error, got %s", boardID)
 		}
 	})
+
+	t.Run("Given two boards sharing a name When looking up ID Then return an error listing both IDs", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`[
+				{"_id": "boardA", "name": "Roadmap", "bins": []},
+				{"_id": "boardB", "name": "roadmap", "bins": []}
+			]`))
+		}))
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+
+		// Act
+		boardID, err := client.LookupBoardIDByName("Roadmap")
+
+		// Assert
+		if !errors.Is(err, ErrAmbiguousBoardName) {
+			t.Fatalf("Expected ErrAmbiguousBoardName, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "boardA, boardB") {
+			t.Errorf("Expected both matching IDs in the error, got %v", err)
+		}
+		if boardID != "" {
+			t.Errorf("Expected empty board ID on error, got %s", boardID)
+		}
+	})
 }
 
 // TestSearchTicketsWithBoardFilter tests filtering tickets by board ID
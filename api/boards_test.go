@@ -108,6 +108,7 @@ func TestGetBoards(t *testing.T) {
 
 		client := NewClient("test-key")
 		client.baseURL = server.URL
+		client.SetMaxRetries(0)
 
 		// Act
 		boards, err := client.GetBoards()
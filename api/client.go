@@ -1,12 +1,18 @@
 package api
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/Germanicus1/fb/models"
@@ -17,9 +23,25 @@ const (
 	httpTimeout          = 30 * time.Second
 )
 
+// Page size constants for paginated endpoints
+const (
+	defaultPageSize  = 1000 // Large pages keep bin and board listing to a single request
+	fallbackPageSize = 100  // Used for a zero or negative page size; accepted by every backend
+)
+
+// Retry constants
+const (
+	defaultMaxRetries       = 3
+	initialBackoff          = 200 * time.Millisecond
+	defaultRateLimitBackoff = time.Second      // Wait after a 429 without a usable Retry-After header
+	defaultMaxRateLimitWait = 30 * time.Second // Longest Retry-After honored before giving up
+)
+
 // HTTP constants
 const (
 	httpMethodGET        = "GET"
+	httpMethodPATCH      = "PATCH"
+	httpMethodPOST       = "POST"
 	headerAuthorization  = "Authorization"
 	headerContentType    = "Content-Type"
 	contentTypeJSON      = "application/json"
@@ -30,55 +52,290 @@ const (
 
 // Client is the Flow Boards API client
 type Client struct {
-	authKey    string
-	baseURL    string
-	httpClient *http.Client
+	authKey          string
+	baseURL          string
+	restDirectoryURL string
+	httpClient       *http.Client
+	noPagination     bool
+	partialResults   bool
+	pageSize         int
+	networkDisabled  bool
+	debugOutput      io.Writer
+	traceOutput      io.Writer
+
+	// proxy picks the proxy for each request, http.ProxyFromEnvironment unless replaced
+	// with SetProxy. Hosts whose proxy choice was already logged under --debug are kept in
+	// proxyLogged so each is reported once.
+	proxy       func(*http.Request) (*url.URL, error)
+	proxyLogged map[string]bool
+
+	// Bins and boards from the last full fetch, reused by the name lookups so a run
+	// that resolves several names only pages through each list once
+	cachedBins   []models.Bin
+	cachedBoards []models.Board
+
+	// Retry behavior. sleep and random are injectable so tests can make backoff
+	// instantaneous and deterministic.
+	maxRetries       int
+	maxRateLimitWait time.Duration
+	sleep            func(time.Duration)
+	random           *rand.Rand
+
+	stats RequestStats
+}
+
+// RequestStats counts the HTTP requests a client has made, to show where a run spends its time
+type RequestStats struct {
+	Calls         int           // Requests sent, counting each retry
+	BytesReceived int64         // Response body bytes read
+	HTTPTime      time.Duration // Time from sending each request until its response body was read
 }
 
-// NewClient creates a new API client with the provided authentication key
+// Add returns the sum of two sets of counters
+func (s RequestStats) Add(other RequestStats) RequestStats {
+	return RequestStats{
+		Calls:         s.Calls + other.Calls,
+		BytesReceived: s.BytesReceived + other.BytesReceived,
+		HTTPTime:      s.HTTPTime + other.HTTPTime,
+	}
+}
+
+// NewClient creates a new API client with the provided authentication key and the
+// default 30-second request timeout
 func NewClient(authKey string) *Client {
+	return NewClientWithTimeout(authKey, httpTimeout)
+}
+
+// NewClientWithTimeout creates a new API client whose requests time out after timeout.
+// A zero or negative timeout means requests never time out.
+func NewClientWithTimeout(authKey string, timeout time.Duration) *Client {
 	return &Client{
-		authKey:    authKey,
-		httpClient: createHTTPClient(),
+		authKey:          authKey,
+		restDirectoryURL: restDirectoryBaseURL,
+		httpClient:       createHTTPClient(timeout, http.ProxyFromEnvironment),
+		proxy:            http.ProxyFromEnvironment,
+		pageSize:         defaultPageSize,
+		maxRetries:       defaultMaxRetries,
+		maxRateLimitWait: defaultMaxRateLimitWait,
+		sleep:            time.Sleep,
+		random:           rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 }
 
-// createHTTPClient creates a configured HTTP client with timeout
-func createHTTPClient() *http.Client {
+// Stats returns the counters of the requests made so far; all zero before the first request
+func (c *Client) Stats() RequestStats {
+	return c.stats
+}
+
+// SetRetryTiming replaces the function used to wait between retries and the source of
+// backoff jitter. Tests use a no-op sleeper and a fixed source for deterministic retries.
+func (c *Client) SetRetryTiming(sleep func(time.Duration), source rand.Source) {
+	c.sleep = sleep
+	c.random = rand.New(source)
+}
+
+// SetMaxRetries sets how many times a transient failure is retried; zero disables retries
+func (c *Client) SetMaxRetries(maxRetries int) {
+	c.maxRetries = maxRetries
+}
+
+// SetMaxRateLimitWait sets the longest wait a 429 response may ask for before the client
+// gives up with ErrRateLimited instead of retrying; zero or negative never waits
+func (c *Client) SetMaxRateLimitWait(wait time.Duration) {
+	c.maxRateLimitWait = wait
+}
+
+// SetRestDirectoryURL overrides the REST directory used by DiscoverRestPrefix
+func (c *Client) SetRestDirectoryURL(directoryURL string) {
+	c.restDirectoryURL = strings.TrimRight(directoryURL, "/")
+}
+
+// SetPaginationDisabled makes paginated endpoints fetch only the first page and ignore
+// any page-token. This is a debugging aid for isolating pagination issues.
+func (c *Client) SetPaginationDisabled(disabled bool) {
+	c.noPagination = disabled
+}
+
+// SetPartialResultsAllowed makes GetBins and GetBoards return the items gathered so far,
+// with a warning wrapping ErrPartialResults, when a page after the first fails. By default
+// any failed page fails the whole call.
+func (c *Client) SetPartialResultsAllowed(allowed bool) {
+	c.partialResults = allowed
+}
+
+// SetPageSize sets the max-results requested per page from paginated endpoints, for
+// backends that reject the default of 1000. Zero or negative sizes fall back to 100.
+func (c *Client) SetPageSize(size int) {
+	c.pageSize = size
+}
+
+// PageSize returns the max-results requested per page, after the fallback for invalid sizes
+func (c *Client) PageSize() int {
+	if c.pageSize <= 0 {
+		return fallbackPageSize
+	}
+	return c.pageSize
+}
+
+// ErrNetworkDisabled is returned for any request attempted while the network is disabled
+var ErrNetworkDisabled = errors.New("network disabled by --no-network")
+
+// SetNetworkDisabled makes every request fail immediately with ErrNetworkDisabled instead of
+// being attempted, so commands cannot hang on blocked network egress
+func (c *Client) SetNetworkDisabled(disabled bool) {
+	c.networkDisabled = disabled
+}
+
+// SetDebugOutput sets where debug details (such as the detected response shape) are
+// written. A nil writer disables debug output, which is the default.
+func (c *Client) SetDebugOutput(output io.Writer) {
+	c.debugOutput = output
+}
+
+// debugf writes a debug line when debug output is enabled
+func (c *Client) debugf(format string, args ...any) {
+	if c.debugOutput != nil {
+		fmt.Fprintf(c.debugOutput, "debug: "+format+"\n", args...)
+	}
+}
+
+// redactedAuthKey replaces the auth key wherever it would appear in trace output
+const redactedAuthKey = "[REDACTED]"
+
+// SetTraceOutput sets where a trace of every request and response is written, with the
+// auth key redacted. A nil writer disables tracing, which is the default.
+func (c *Client) SetTraceOutput(output io.Writer) {
+	c.traceOutput = output
+}
+
+// tracef writes a trace line when tracing is enabled, redacting the auth key
+func (c *Client) tracef(format string, args ...any) {
+	if c.traceOutput == nil {
+		return
+	}
+	line := fmt.Sprintf(format, args...)
+	if strings.TrimSpace(c.authKey) != "" {
+		line = strings.ReplaceAll(line, c.authKey, redactedAuthKey)
+	}
+	fmt.Fprintln(c.traceOutput, line)
+}
+
+// createHTTPClient creates a configured HTTP client with timeout; zero or negative disables it.
+// Its transport is the default one with proxy set explicitly, so HTTP_PROXY, HTTPS_PROXY,
+// and NO_PROXY are honored unless SetProxy replaces it.
+func createHTTPClient(timeout time.Duration, proxy func(*http.Request) (*url.URL, error)) *http.Client {
+	if timeout < 0 {
+		timeout = 0
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = proxy
 	return &http.Client{
-		Timeout: httpTimeout,
+		Timeout:   timeout,
+		Transport: transport,
+	}
+}
+
+// SetProxy replaces how the proxy for each request is chosen, e.g. with http.ProxyURL to
+// force one proxy; nil connects directly. The default is http.ProxyFromEnvironment.
+func (c *Client) SetProxy(proxy func(*http.Request) (*url.URL, error)) {
+	if proxy == nil {
+		proxy = func(*http.Request) (*url.URL, error) { return nil, nil }
+	}
+	c.proxy = proxy
+	c.httpClient.Transport.(*http.Transport).Proxy = proxy
+	c.proxyLogged = nil
+}
+
+// logProxy writes a debug line saying whether req goes through a proxy. Each host is logged
+// once, and proxy credentials are redacted.
+func (c *Client) logProxy(req *http.Request) {
+	if c.debugOutput == nil || c.proxyLogged[req.URL.Host] {
+		return
 	}
+	if c.proxyLogged == nil {
+		c.proxyLogged = map[string]bool{}
+	}
+	c.proxyLogged[req.URL.Host] = true
+
+	proxyURL, err := c.proxy(req)
+	switch {
+	case err != nil:
+		c.debugf("proxy lookup for %s failed: %v", req.URL.Host, err)
+	case proxyURL == nil:
+		c.debugf("no proxy for %s, connecting directly", req.URL.Host)
+	default:
+		c.debugf("using proxy %s for %s", proxyURL.Redacted(), req.URL.Host)
+	}
+}
+
+// Timeout returns the request timeout, or zero when requests never time out
+func (c *Client) Timeout() time.Duration {
+	return c.httpClient.Timeout
 }
 
 // DiscoverRestPrefix discovers the REST API prefix for the organization
 func (c *Client) DiscoverRestPrefix(orgID string) error {
-	discoveryURL := buildRestDirectoryURL(orgID)
+	return c.DiscoverRestPrefixWithContext(context.Background(), orgID)
+}
 
-	resp, err := c.doRequestWithoutBase(httpMethodGET, discoveryURL, nil)
+// DiscoverRestPrefixWithContext discovers the REST API prefix for the organization, aborting
+// when ctx is done
+func (c *Client) DiscoverRestPrefixWithContext(ctx context.Context, orgID string) error {
+	discoveryURL := buildRestDirectoryURL(c.restDirectoryURL, orgID)
+
+	resp, statusCode, err := c.doRequestWithStatus(ctx, httpMethodGET, discoveryURL, nil)
 	if err != nil {
 		return fmt.Errorf("failed to discover REST prefix: %w", err)
 	}
 
-	prefixResp, err := parseRestPrefixResponse(resp)
+	prefixResp, err := parseRestPrefixResponse(resp, statusCode)
 	if err != nil {
 		return err
 	}
 
 	if prefixResp.RestPrefix == "" {
-		return fmt.Errorf("REST prefix not found in response")
+		return fmt.Errorf("Flow Boards returned no REST endpoint for org '%s'; double-check your org_id", orgID)
 	}
 
 	c.baseURL = prefixResp.RestPrefix
 	return nil
 }
 
+// RestPrefix returns the REST prefix found by DiscoverRestPrefix or set by SetRestPrefix
+func (c *Client) RestPrefix() string {
+	return c.baseURL
+}
+
+// RestDirectoryURL returns the REST directory used by DiscoverRestPrefix
+func (c *Client) RestDirectoryURL() string {
+	return c.restDirectoryURL
+}
+
+// SetRestPrefix uses a previously discovered REST prefix, skipping DiscoverRestPrefix
+func (c *Client) SetRestPrefix(prefix string) {
+	c.baseURL = prefix
+}
+
 // buildRestDirectoryURL constructs the REST directory discovery URL
-func buildRestDirectoryURL(orgID string) string {
-	return fmt.Sprintf("%s/%s", restDirectoryBaseURL, orgID)
+func buildRestDirectoryURL(directoryURL, orgID string) string {
+	return fmt.Sprintf("%s/%s", directoryURL, orgID)
 }
 
-// parseRestPrefixResponse parses the REST prefix discovery response
-func parseRestPrefixResponse(data []byte) (*models.RestPrefixResponse, error) {
+// nonJSONSnippetLength is how much of a non-JSON body is quoted in the error
+const nonJSONSnippetLength = 100
+
+// parseRestPrefixResponse parses the REST prefix discovery response. A body that is not
+// JSON at all, such as a proxy's HTML login page, gets an error quoting the start of it.
+func parseRestPrefixResponse(data []byte, statusCode int) (*models.RestPrefixResponse, error) {
+	if !json.Valid(data) {
+		snippet := data
+		if len(snippet) > nonJSONSnippetLength {
+			snippet = snippet[:nonJSONSnippetLength]
+		}
+		return nil, fmt.Errorf("unexpected non-JSON response from REST directory (status %d); check org_id and network/proxy: %q",
+			statusCode, snippet)
+	}
+
 	var prefixResp models.RestPrefixResponse
 	if err := json.Unmarshal(data, &prefixResp); err != nil {
 		return nil, fmt.Errorf("failed to parse REST prefix response: %w", err)
@@ -88,12 +345,17 @@ func parseRestPrefixResponse(data []byte) (*models.RestPrefixResponse, error) {
 
 // GetCurrentUser retrieves the user information by email
 func (c *Client) GetCurrentUser(email string) (*models.User, error) {
+	return c.GetCurrentUserWithContext(context.Background(), email)
+}
+
+// GetCurrentUserWithContext retrieves the user information by email, aborting when ctx is done
+func (c *Client) GetCurrentUserWithContext(ctx context.Context, email string) (*models.User, error) {
 	if err := c.requireBaseURL(); err != nil {
 		return nil, err
 	}
 
 	path := buildUserPath(email)
-	resp, err := c.doRequest(httpMethodGET, path, nil)
+	resp, err := c.doRequest(ctx, httpMethodGET, path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
@@ -130,28 +392,69 @@ func parseUserResponse(data []byte) (*models.User, error) {
 
 // SearchTickets searches for tickets assigned to the given user IDs
 func (c *Client) SearchTickets(userIDs []string) ([]models.Ticket, error) {
-	return c.SearchTicketsWithFilters(userIDs, "", "")
+	return c.SearchTicketsWithContext(context.Background(), userIDs)
+}
+
+// SearchTicketsWithContext searches for tickets assigned to the given user IDs, aborting when ctx is done
+func (c *Client) SearchTicketsWithContext(ctx context.Context, userIDs []string) ([]models.Ticket, error) {
+	return c.SearchTicketsWithFiltersContext(ctx, userIDs, "", "")
 }
 
 // SearchTicketsWithFilters searches for tickets with optional bin and board filters
 func (c *Client) SearchTicketsWithFilters(userIDs []string, binID, boardID string) ([]models.Ticket, error) {
+	return c.SearchTicketsWithFiltersContext(context.Background(), userIDs, binID, boardID)
+}
+
+// SearchTicketsWithFiltersContext searches for tickets with optional bin and board filters,
+// aborting when ctx is done
+func (c *Client) SearchTicketsWithFiltersContext(ctx context.Context, userIDs []string, binID, boardID string) ([]models.Ticket, error) {
 	if err := c.requireBaseURL(); err != nil {
 		return nil, err
 	}
 
-	path := buildTicketSearchPathWithFilters(userIDs, binID, boardID)
+	basePath := buildTicketSearchPathWithFilters(userIDs, binID, boardID)
 
-	resp, err := c.doRequest(httpMethodGET, path, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to search tickets: %w", err)
-	}
+	var allTickets []models.Ticket
+	pageToken := ""
 
-	tickets, err := parseTicketSearchResponse(resp)
-	if err != nil {
-		return nil, err
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		path := basePath
+		if pageToken != "" {
+			path += "&page-token=" + url.QueryEscape(pageToken)
+		}
+
+		resp, err := c.doRequest(ctx, httpMethodGET, path, nil)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, fmt.Errorf("failed to search tickets: %w", err)
+		}
+
+		tickets, nextToken, shape, err := parseTicketSearchResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+		c.debugf("ticket search response shape: %s", shape)
+
+		// Keep the first page as parsed, so an empty result stays an empty slice
+		if pageToken == "" {
+			allTickets = tickets
+		} else {
+			allTickets = append(allTickets, tickets...)
+		}
+
+		if nextToken == "" || c.noPagination {
+			break
+		}
+		pageToken = nextToken
 	}
 
-	return tickets, nil
+	return allTickets, nil
 }
 
 // buildTicketSearchPath constructs the ticket search API path with comma-separated user IDs
@@ -179,18 +482,50 @@ func buildTicketSearchPathWithFilters(userIDs []string, binID, boardID string) s
 	return "/ticket-search?" + strings.Join(params, "&")
 }
 
-// parseTicketSearchResponse parses the ticket search API response
-func parseTicketSearchResponse(data []byte) ([]models.Ticket, error) {
-	// The API returns an array of tickets directly
+// ticketWrapperKeys are the object keys that deployments are known to wrap the ticket array in,
+// in the order they are tried
+var ticketWrapperKeys = []string{"tickets", "results", "data"}
+
+// parseTicketSearchResponse parses a page of the ticket search API response.
+// The API normally returns an array of tickets directly, but some deployments wrap it in an
+// object under one of ticketWrapperKeys, paginated like bins with a page-token next to it.
+// It returns the tickets, the next page token, and a description of the detected shape.
+func parseTicketSearchResponse(data []byte) ([]models.Ticket, string, string, error) {
+	var wrapped map[string]json.RawMessage
+	if err := json.Unmarshal(data, &wrapped); err == nil {
+		for _, key := range ticketWrapperKeys {
+			raw, ok := wrapped[key]
+			if !ok {
+				continue
+			}
+			var tickets []models.Ticket
+			if err := json.Unmarshal(raw, &tickets); err != nil {
+				return nil, "", "", fmt.Errorf("failed to parse ticket response under %q: %w", key, err)
+			}
+			var pageToken string
+			if rawToken, ok := wrapped["page-token"]; ok {
+				json.Unmarshal(rawToken, &pageToken)
+			}
+			return tickets, pageToken, fmt.Sprintf("object with %q key", key), nil
+		}
+	}
+
+	// Fall back to a bare array of tickets, which is never paginated
 	var tickets []models.Ticket
 	if err := json.Unmarshal(data, &tickets); err != nil {
-		return nil, fmt.Errorf("failed to parse ticket response: %w", err)
+		return nil, "", "", fmt.Errorf("failed to parse ticket response: %w", err)
 	}
-	return tickets, nil
+	return tickets, "", "bare array", nil
 }
 
 // GetBins retrieves all bins from the API
 func (c *Client) GetBins() ([]models.Bin, error) {
+	return c.GetBinsWithContext(context.Background())
+}
+
+// GetBinsWithContext retrieves all bins from the API. Cancelling ctx aborts the
+// pagination loop, returning ctx.Err().
+func (c *Client) GetBinsWithContext(ctx context.Context) ([]models.Bin, error) {
 	if err := c.requireBaseURL(); err != nil {
 		return nil, err
 	}
@@ -199,48 +534,119 @@ func (c *Client) GetBins() ([]models.Bin, error) {
 	pageToken := ""
 
 	for {
-		path := buildPaginatedPath("/bins", pageToken)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		path := buildPaginatedPath("/bins", pageToken, c.PageSize())
 
-		resp, err := c.doRequest(httpMethodGET, path, nil)
+		resp, err := c.doRequest(ctx, httpMethodGET, path, nil)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get bins: %w", err)
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return partialOrFail(c, "bins", allBins, fmt.Errorf("failed to get bins: %w", err))
 		}
 
 		bins, nextToken, err := parseBinsPage(resp)
 		if err != nil {
-			return nil, err
+			return partialOrFail(c, "bins", allBins, err)
 		}
 
 		allBins = append(allBins, bins...)
 
-		if nextToken == "" {
+		if nextToken == "" || c.noPagination {
 			break
 		}
 		pageToken = nextToken
 	}
 
+	c.cachedBins = allBins
 	return allBins, nil
 }
 
-// LookupBinIDByName looks up a bin ID by name (case-insensitive)
+// ErrPartialResults is wrapped by the warning returned with incomplete results when partial
+// results are allowed and a page after the first fails
+var ErrPartialResults = errors.New("partial results")
+
+// partialOrFail handles a failed page. With partial results allowed and earlier pages
+// gathered, it returns those items with a warning wrapping both ErrPartialResults and err;
+// otherwise it fails with err. Partial results are never cached.
+func partialOrFail[T any](c *Client, kind string, gathered []T, err error) ([]T, error) {
+	if !c.partialResults || len(gathered) == 0 {
+		return nil, err
+	}
+	return gathered, fmt.Errorf("%w: returning %d %s fetched before a later page failed: %w", ErrPartialResults, len(gathered), kind, err)
+}
+
+// ErrBinNotFound is returned by FindBin and the bin lookups when no bin has the given name
+var ErrBinNotFound = errors.New("bin not found")
+
+// ErrAmbiguousBinName is returned by FindBin and the bin lookups when several bins have the
+// given name. Bin names are only unique within a board, so the error lists every matching ID.
+var ErrAmbiguousBinName = errors.New("several bins share this name")
+
+// LookupBinIDByName looks up a bin ID by name (case-insensitive), see LookupBin
 func (c *Client) LookupBinIDByName(binName string) (string, error) {
-	bins, err := c.GetBins()
+	bin, err := c.LookupBin(binName)
 	if err != nil {
 		return "", err
 	}
+	return bin.ID, nil
+}
+
+// LookupBin finds the bin with the given ID or name, see FindBin. Bins are fetched
+// on the first lookup and reused until InvalidateCache is called.
+func (c *Client) LookupBin(query string) (models.Bin, error) {
+	bins := c.cachedBins
+	if bins == nil {
+		var err error
+		if bins, err = c.GetBins(); err != nil {
+			return models.Bin{}, err
+		}
+	}
+	return FindBin(bins, query)
+}
 
-	lowerBinName := strings.ToLower(binName)
+// FindBin returns the bin whose ID is query, or else the only bin whose name matches query
+// case-insensitively. No match wraps ErrBinNotFound, and several name matches wrap
+// ErrAmbiguousBinName with the matching IDs, rather than silently picking one.
+func FindBin(bins []models.Bin, query string) (models.Bin, error) {
 	for _, bin := range bins {
-		if strings.ToLower(bin.Name) == lowerBinName {
-			return bin.ID, nil
+		if bin.ID == query {
+			return bin, nil
 		}
 	}
 
-	return "", fmt.Errorf("bin not found: %s", binName)
+	var matches []models.Bin
+	for _, bin := range bins {
+		if strings.EqualFold(bin.Name, query) {
+			matches = append(matches, bin)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return models.Bin{}, fmt.Errorf("%w: %s", ErrBinNotFound, query)
+	case 1:
+		return matches[0], nil
+	}
+	ids := make([]string, len(matches))
+	for i, bin := range matches {
+		ids[i] = bin.ID
+	}
+	return models.Bin{}, fmt.Errorf("%w: '%s' matches bin IDs %s; use one of the IDs instead",
+		ErrAmbiguousBinName, query, strings.Join(ids, ", "))
 }
 
 // GetBoards retrieves all boards from the API
 func (c *Client) GetBoards() ([]models.Board, error) {
+	return c.GetBoardsWithContext(context.Background())
+}
+
+// GetBoardsWithContext retrieves all boards from the API. Cancelling ctx aborts the
+// pagination loop, returning ctx.Err().
+func (c *Client) GetBoardsWithContext(ctx context.Context) ([]models.Board, error) {
 	if err := c.requireBaseURL(); err != nil {
 		return nil, err
 	}
@@ -249,80 +655,260 @@ func (c *Client) GetBoards() ([]models.Board, error) {
 	pageToken := ""
 
 	for {
-		path := buildPaginatedPath("/boards", pageToken)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		path := buildPaginatedPath("/boards", pageToken, c.PageSize())
 
-		resp, err := c.doRequest(httpMethodGET, path, nil)
+		resp, err := c.doRequest(ctx, httpMethodGET, path, nil)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get boards: %w", err)
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return partialOrFail(c, "boards", allBoards, fmt.Errorf("failed to get boards: %w", err))
 		}
 
 		boards, nextToken, err := parseBoardsPage(resp)
 		if err != nil {
-			return nil, err
+			return partialOrFail(c, "boards", allBoards, err)
 		}
 
 		allBoards = append(allBoards, boards...)
 
-		if nextToken == "" {
+		if nextToken == "" || c.noPagination {
 			break
 		}
 		pageToken = nextToken
 	}
 
+	c.cachedBoards = allBoards
 	return allBoards, nil
 }
 
-// LookupBoardIDByName looks up a board ID by name (case-insensitive)
+// ErrBoardNotFound is returned by FindBoard and LookupBoardIDByName when no board has the given name
+var ErrBoardNotFound = errors.New("board not found")
+
+// ErrAmbiguousBoardName is returned by FindBoard and LookupBoardIDByName when several boards
+// have the given name; the error lists every matching ID.
+var ErrAmbiguousBoardName = errors.New("several boards share this name")
+
+// LookupBoardIDByName looks up a board ID by name (case-insensitive), see FindBoard. Boards
+// are fetched on the first lookup and reused until InvalidateCache is called.
 func (c *Client) LookupBoardIDByName(boardName string) (string, error) {
-	boards, err := c.GetBoards()
+	boards := c.cachedBoards
+	if boards == nil {
+		var err error
+		if boards, err = c.GetBoards(); err != nil {
+			return "", err
+		}
+	}
+
+	board, err := FindBoard(boards, boardName)
 	if err != nil {
 		return "", err
 	}
+	return board.ID, nil
+}
 
-	lowerBoardName := strings.ToLower(boardName)
+// FindBoard returns the board whose ID is query, or else the only board whose name matches
+// query case-insensitively, with the same errors as FindBin for no match and several matches
+func FindBoard(boards []models.Board, query string) (models.Board, error) {
 	for _, board := range boards {
-		if strings.ToLower(board.Name) == lowerBoardName {
-			return board.ID, nil
+		if board.ID == query {
+			return board, nil
 		}
 	}
 
-	return "", fmt.Errorf("board not found: %s", boardName)
+	var matches []models.Board
+	for _, board := range boards {
+		if strings.EqualFold(board.Name, query) {
+			matches = append(matches, board)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return models.Board{}, fmt.Errorf("%w: %s", ErrBoardNotFound, query)
+	case 1:
+		return matches[0], nil
+	}
+	ids := make([]string, len(matches))
+	for i, board := range matches {
+		ids[i] = board.ID
+	}
+	return models.Board{}, fmt.Errorf("%w: '%s' matches board IDs %s; use one of the IDs instead",
+		ErrAmbiguousBoardName, query, strings.Join(ids, ", "))
+}
+
+// InvalidateCache drops the bins and boards kept for name lookups, so the next lookup
+// fetches them again
+func (c *Client) InvalidateCache() {
+	c.cachedBins = nil
+	c.cachedBoards = nil
 }
 
 // doRequest makes an HTTP request with authentication using the base URL
-func (c *Client) doRequest(method, path string, body io.Reader) ([]byte, error) {
+func (c *Client) doRequest(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
 	fullURL := c.baseURL + path
-	return c.doRequestWithoutBase(method, fullURL, body)
+	return c.doRequestWithoutBase(ctx, method, fullURL, body)
+}
+
+// doRequestWithoutBase makes an HTTP request with authentication without using the base URL.
+// Transient failures of GET requests are retried with exponential backoff up to maxRetries
+// times; writes are sent once, since replaying one could post a comment twice. A 429 is
+// retried once, waiting as long as its Retry-After header asks, or 1s without one, unless that
+// exceeds the max wait; a second 429 fails with ErrRateLimited.
+func (c *Client) doRequestWithoutBase(ctx context.Context, method, fullURL string, body io.Reader) ([]byte, error) {
+	respBody, _, err := c.doRequestWithStatus(ctx, method, fullURL, body)
+	return respBody, err
+}
+
+// doRequestWithStatus is doRequestWithoutBase that also returns the status code of the
+// successful response, so callers can tell apart 2xx codes such as 201 and 204
+func (c *Client) doRequestWithStatus(ctx context.Context, method, fullURL string, body io.Reader) ([]byte, int, error) {
+	// Buffer the body so it can be resent on retries
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = io.ReadAll(body); err != nil {
+			return nil, 0, fmt.Errorf("failed to read request body: %w", err)
+		}
+	}
+
+	rateLimited := false
+	for attempt := 0; ; attempt++ {
+		respBody, statusCode, retryAfter, err := c.doSingleRequest(ctx, method, fullURL, payload)
+		if err == nil {
+			return respBody, statusCode, nil
+		}
+		if attempt >= c.maxRetries || method != httpMethodGET || !isRetryable(statusCode, err) {
+			return nil, statusCode, err
+		}
+
+		delay := c.backoffDelay(attempt)
+		if statusCode == http.StatusTooManyRequests {
+			if rateLimited {
+				c.debugf("still rate limited after one retry; not retrying again")
+				return nil, statusCode, err
+			}
+			rateLimited = true
+			if delay = retryAfter; delay == 0 {
+				delay = defaultRateLimitBackoff
+			}
+			if delay > c.maxRateLimitWait {
+				c.debugf("rate limited for %s, longer than the %s max wait; not retrying", delay, c.maxRateLimitWait)
+				return nil, statusCode, err
+			}
+		}
+		c.debugf("request failed with status %d, retrying in %s (attempt %d of %d)", statusCode, delay, attempt+1, c.maxRetries)
+		c.sleep(delay)
+		if err := ctx.Err(); err != nil {
+			return nil, statusCode, err
+		}
+	}
 }
 
-// doRequestWithoutBase makes an HTTP request with authentication without using the base URL
-func (c *Client) doRequestWithoutBase(method, fullURL string, body io.Reader) ([]byte, error) {
-	req, err := c.createRequest(method, fullURL, body)
+// doSingleRequest sends one HTTP request and returns the response body and status code.
+// The status code is zero when the request failed before a response was received. For a
+// 429 response, the wait requested by its Retry-After header is also returned.
+func (c *Client) doSingleRequest(ctx context.Context, method, fullURL string, payload []byte) ([]byte, int, time.Duration, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := c.createRequest(ctx, method, fullURL, body)
 	if err != nil {
-		return nil, err
+		return nil, 0, 0, err
 	}
 
+	if len(payload) > 0 {
+		c.tracef("> %s %s %s", method, fullURL, payload)
+	} else {
+		c.tracef("> %s %s", method, fullURL)
+	}
+	c.logProxy(req)
+	start := time.Now()
+	c.stats.Calls++
 	resp, err := c.executeRequest(req)
 	if err != nil {
-		return nil, err
+		c.stats.HTTPTime += time.Since(start)
+		c.tracef("< %v", err)
+		return nil, 0, 0, err
 	}
 	defer resp.Body.Close()
 
 	respBody, err := readResponseBody(resp)
+	c.stats.BytesReceived += int64(len(respBody))
+	c.stats.HTTPTime += time.Since(start)
 	if err != nil {
-		return nil, err
+		return nil, resp.StatusCode, 0, err
 	}
+	c.tracef("< %d %s", resp.StatusCode, respBody)
 
 	if err := checkStatusCode(resp.StatusCode, respBody); err != nil {
-		return nil, err
+		var retryAfter time.Duration
+		if resp.StatusCode == http.StatusTooManyRequests {
+			retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		}
+		return nil, resp.StatusCode, retryAfter, err
 	}
 
-	return respBody, nil
+	return respBody, resp.StatusCode, 0, nil
+}
+
+// isRetryable reports whether a failed request is worth retrying: rate limiting, a server
+// or gateway failure, or a connection reset before any response was received
+func isRetryable(statusCode int, err error) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError,
+		http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	case 0:
+		return errors.Is(err, syscall.ECONNRESET)
+	}
+	return false
 }
 
-// createRequest creates an HTTP request with authentication headers
-func (c *Client) createRequest(method, fullURL string, body io.Reader) (*http.Request, error) {
-	req, err := http.NewRequest(method, fullURL, body)
+// parseRetryAfter converts a Retry-After header, given either in seconds or as an HTTP date,
+// into a wait. It returns zero when the header is absent, invalid, or already in the past.
+func parseRetryAfter(header string, now time.Time) time.Duration {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(header); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+	return 0
+}
+
+// backoffDelay returns the wait before retry number attempt (0-based): an exponential
+// backoff starting at initialBackoff plus up to the same amount again of random jitter
+func (c *Client) backoffDelay(attempt int) time.Duration {
+	backoff := initialBackoff << attempt
+	return backoff + time.Duration(c.random.Int63n(int64(backoff)))
+}
+
+// createRequest creates an HTTP request with authentication headers.
+// It fails before anything is sent if the client has no auth key, rather than
+// letting the server reject the request with an opaque 401.
+func (c *Client) createRequest(ctx context.Context, method, fullURL string, body io.Reader) (*http.Request, error) {
+	if c.networkDisabled {
+		return nil, ErrNetworkDisabled
+	}
+	if strings.TrimSpace(c.authKey) == "" {
+		return nil, fmt.Errorf("empty auth key: set auth_key in ~/.fb/config.yaml before making API requests")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, fullURL, body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -355,17 +941,56 @@ func readResponseBody(resp *http.Response) ([]byte, error) {
 	return respBody, nil
 }
 
-// checkStatusCode validates the HTTP status code is in the 2xx range
+// Sentinel errors matched by errors.Is against an *APIError of the corresponding status
+var (
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrServer       = errors.New("server error")
+	ErrRateLimited  = errors.New("rate limited")
+)
+
+// APIError is returned for a response outside the 2xx range. It carries the status code
+// and the trimmed response body.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+// Error reports the status code and body
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+// Is matches the sentinel error for the status: ErrUnauthorized for 401, ErrForbidden
+// for 403, ErrNotFound for 404, ErrRateLimited for 429, and ErrServer for any 5xx
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServer:
+		return e.StatusCode >= http.StatusInternalServerError && e.StatusCode < 600
+	}
+	return false
+}
+
+// checkStatusCode validates the HTTP status code is in the 2xx range, returning an *APIError otherwise
 func checkStatusCode(statusCode int, respBody []byte) error {
 	if statusCode < httpStatusOK || statusCode >= httpStatusMultipleOK {
-		return fmt.Errorf("API request failed with status %d: %s", statusCode, strings.TrimSpace(string(respBody)))
+		return &APIError{StatusCode: statusCode, Body: strings.TrimSpace(string(respBody))}
 	}
 	return nil
 }
 
 // buildPaginatedPath constructs a paginated API path with max-results and optional page-token
-func buildPaginatedPath(basePath string, pageToken string) string {
-	path := basePath + "?max-results=1000"
+func buildPaginatedPath(basePath string, pageToken string, pageSize int) string {
+	path := fmt.Sprintf("%s?max-results=%d", basePath, pageSize)
 	if pageToken != "" {
 		path += "&page-token=" + url.QueryEscape(pageToken)
 	}
@@ -406,23 +1031,108 @@ func parseBoardsPage(data []byte) ([]models.Board, string, error) {
 	return boards, "", nil
 }
 
+// CommentResult describes the API's response to a posted comment
+type CommentResult struct {
+	StatusCode int    // 2xx status of the response; 200 or 201 confirms the comment was saved
+	ID         string // Comment ID returned in the response body, or empty if none was returned
+}
+
+// Confirmed reports whether the response status confirms the comment was saved, rather than
+// only accepted (202) or answered without content (204)
+func (r *CommentResult) Confirmed() bool {
+	return r.StatusCode == http.StatusOK || r.StatusCode == http.StatusCreated
+}
+
 // PostComment posts a comment to a ticket
 func (c *Client) PostComment(payload models.CommentPayload) error {
+	_, err := c.PostCommentWithResult(payload)
+	return err
+}
+
+// PostCommentWithResult posts a comment to a ticket and returns the response status and any
+// comment ID in the response body, so callers can verify the write took effect
+func (c *Client) PostCommentWithResult(payload models.CommentPayload) (*CommentResult, error) {
+	return c.PostCommentWithResultContext(context.Background(), payload)
+}
+
+// PostCommentWithResultContext is PostCommentWithResult aborting when ctx is done. A comment
+// cancelled while in flight may still have been saved.
+func (c *Client) PostCommentWithResultContext(ctx context.Context, payload models.CommentPayload) (*CommentResult, error) {
 	if err := c.requireBaseURL(); err != nil {
-		return err
+		return nil, err
 	}
 
 	path := fmt.Sprintf("/ticket-comments/%s", url.PathEscape(payload.ID))
 
 	jsonData, err := json.Marshal(payload)
 	if err != nil {
-		return fmt.Errorf("failed to marshal comment payload: %w", err)
+		return nil, fmt.Errorf("failed to marshal comment payload: %w", err)
+	}
+
+	resp, statusCode, err := c.doRequestWithStatus(ctx, httpMethodPOST, c.baseURL+path, strings.NewReader(string(jsonData)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to post comment: %w", err)
+	}
+
+	result := &CommentResult{StatusCode: statusCode, ID: parseCommentID(resp)}
+	if !result.Confirmed() {
+		c.debugf("comment request returned status %d; the comment may not have been saved yet", statusCode)
+	}
+	return result, nil
+}
+
+// parseCommentID returns the _id of the comment object in a response body, or an empty
+// string when the body is empty or not a comment object
+func parseCommentID(data []byte) string {
+	var comment struct {
+		ID string `json:"_id"`
+	}
+	if err := json.Unmarshal(data, &comment); err != nil {
+		return ""
+	}
+	return comment.ID
+}
+
+// UpdateTicket updates the given fields of a ticket.
+// Only the keys present in fields are sent, so omitted fields are left unchanged.
+func (c *Client) UpdateTicket(ticketID string, fields map[string]any) error {
+	return c.UpdateTicketWithContext(context.Background(), ticketID, fields)
+}
+
+// UpdateTicketWithContext updates the given fields of a ticket, aborting when ctx is done
+func (c *Client) UpdateTicketWithContext(ctx context.Context, ticketID string, fields map[string]any) error {
+	if err := c.requireBaseURL(); err != nil {
+		return err
+	}
+
+	if len(fields) == 0 {
+		return fmt.Errorf("no ticket fields to update")
+	}
+
+	path := fmt.Sprintf("/tickets/%s", url.PathEscape(ticketID))
+
+	jsonData, err := json.Marshal(fields)
+	if err != nil {
+		return fmt.Errorf("failed to marshal ticket update: %w", err)
 	}
 
-	_, err = c.doRequest("POST", path, strings.NewReader(string(jsonData)))
+	_, err = c.doRequest(ctx, httpMethodPATCH, path, bytes.NewReader(jsonData))
 	if err != nil {
-		return fmt.Errorf("failed to post comment: %w", err)
+		return fmt.Errorf("failed to update ticket: %w", err)
 	}
 
 	return nil
 }
+
+// MoveTicket moves a ticket to another bin by updating its bin_id
+func (c *Client) MoveTicket(ticketID, targetBinID string) error {
+	return c.MoveTicketWithContext(context.Background(), ticketID, targetBinID)
+}
+
+// MoveTicketWithContext moves a ticket to another bin, aborting when ctx is done
+func (c *Client) MoveTicketWithContext(ctx context.Context, ticketID, targetBinID string) error {
+	if targetBinID == "" {
+		return fmt.Errorf("target bin ID cannot be empty")
+	}
+	return c.UpdateTicketWithContext(ctx, ticketID, map[string]any{"bin_id": targetBinID})
+}
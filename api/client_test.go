@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -24,7 +25,7 @@ func TestStory1_4_SuccessfulAPICall(t *testing.T) {
 
 	// When: Making an API call
 	client := NewClient("test-auth-key")
-	body, err := client.doRequestWithoutBase("GET", server.URL, nil)
+	body, err := client.doRequestWithoutBase(context.Background(), "GET", server.URL, nil)
 
 	// Then: Should succeed without error
 	if err != nil {
@@ -47,7 +48,7 @@ func TestStory1_4_HTTP401Unauthorized(t *testing.T) {
 
 	// When: Making an API call
 	client := NewClient("invalid-auth-key")
-	_, err := client.doRequestWithoutBase("GET", server.URL, nil)
+	_, err := client.doRequestWithoutBase(context.Background(), "GET", server.URL, nil)
 
 	// Then: Should return error with clear message about authentication
 	if err == nil {
@@ -71,7 +72,7 @@ func TestStory1_4_HTTP403Forbidden(t *testing.T) {
 
 	// When: Making an API call
 	client := NewClient("test-auth-key")
-	_, err := client.doRequestWithoutBase("GET", server.URL, nil)
+	_, err := client.doRequestWithoutBase(context.Background(), "GET", server.URL, nil)
 
 	// Then: Should return error with clear message about access
 	if err == nil {
@@ -91,7 +92,7 @@ func TestStory1_4_NetworkError(t *testing.T) {
 
 	// When: Making an API call
 	client := NewClient("test-auth-key")
-	_, err := client.doRequestWithoutBase("GET", invalidURL, nil)
+	_, err := client.doRequestWithoutBase(context.Background(), "GET", invalidURL, nil)
 
 	// Then: Should return error with clear message about network
 	if err == nil {
@@ -126,7 +127,7 @@ func TestStory1_4_BearerTokenAuthentication(t *testing.T) {
 
 	// When: Making an API call with the token
 	client := NewClient(expectedToken)
-	_, err := client.doRequestWithoutBase("GET", server.URL, nil)
+	_, err := client.doRequestWithoutBase(context.Background(), "GET", server.URL, nil)
 
 	// Then: Should send the correct bearer token
 	if err != nil {
@@ -149,7 +150,7 @@ func TestStory1_4_DiscoverRestPrefix(t *testing.T) {
 
 	// When: Making a request to get REST prefix info
 	client := NewClient("test-auth-key")
-	body, err := client.doRequestWithoutBase("GET", server.URL, nil)
+	body, err := client.doRequestWithoutBase(context.Background(), "GET", server.URL, nil)
 
 	// Then: Should successfully get the response
 	if err != nil {
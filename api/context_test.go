@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestContextCancellation tests the context-aware request path
+//
+// Acceptance Criteria:
+// - Cancelling the context mid-pagination in GetBins aborts before the next page and returns ctx.Err()
+// - A deadline shorter than the client timeout aborts a slow request
+// - The methods without a context keep working
+func TestContextCancellation(t *testing.T) {
+	t.Run("Given a cancelled context mid-pagination When fetching bins Then it aborts with ctx.Err()", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		requestCount := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestCount++
+			// Cancel while the first page is being served, as a user pressing Ctrl-C would
+			cancel()
+			fmt.Fprintf(w, `{"results": [{"_id": "bin%d", "name": "Bin"}], "page-token": "next"}`, requestCount)
+		}))
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+
+		bins, err := client.GetBinsWithContext(ctx)
+
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("Expected context.Canceled, got %v", err)
+		}
+		if bins != nil {
+			t.Errorf("Expected no bins, got %v", bins)
+		}
+		if requestCount != 1 {
+			t.Errorf("Expected pagination to stop after 1 request, got %d", requestCount)
+		}
+	})
+
+	t.Run("Given a short deadline When the server is slow Then the request is aborted", func(t *testing.T) {
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-release:
+			case <-r.Context().Done():
+			}
+		}))
+		defer server.Close()
+		defer close(release)
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := client.GetBoardsWithContext(ctx)
+
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("Expected the deadline to abort the request, took %s", elapsed)
+		}
+	})
+
+	t.Run("Given no context When fetching bins Then the background context is used", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`[{"_id": "bin1", "name": "Bin One"}]`))
+		}))
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+
+		bins, err := client.GetBins()
+		if err != nil || len(bins) != 1 {
+			t.Errorf("Expected 1 bin, got %v (err %v)", bins, err)
+		}
+	})
+}
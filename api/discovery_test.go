@@ -0,0 +1,81 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestDiscoverRestPrefix tests REST prefix discovery against the REST directory
+func TestDiscoverRestPrefix(t *testing.T) {
+	t.Run("Given a directory response with a prefix When discovering Then the base URL is set", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/my-org" {
+				t.Errorf("Expected path /my-org, got %s", r.URL.Path)
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"restUrlPrefix": "https://api.example.com/rest/2"}`))
+		}))
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.restDirectoryURL = server.URL
+
+		if err := client.DiscoverRestPrefix("my-org"); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if client.baseURL != "https://api.example.com/rest/2" {
+			t.Errorf("Expected base URL to be set, got %q", client.baseURL)
+		}
+	})
+
+	t.Run("Given a 200 response without a prefix When discovering Then the error names the org_id", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"name": "Some Org"}`))
+		}))
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.restDirectoryURL = server.URL
+
+		err := client.DiscoverRestPrefix("typo-org")
+
+		if err == nil {
+			t.Fatal("Expected error for missing REST prefix, got nil")
+		}
+		if !strings.Contains(err.Error(), "'typo-org'") {
+			t.Errorf("Expected error to mention the org_id, got: %v", err)
+		}
+		if !strings.Contains(err.Error(), "org_id") {
+			t.Errorf("Expected error to suggest checking org_id, got: %v", err)
+		}
+	})
+	t.Run("Given a proxy HTML page When discovering Then the error explains the non-JSON response", func(t *testing.T) {
+		page := "<!DOCTYPE html><html><head><title>Corporate Proxy Login</title></head><body>" + strings.Repeat("x", 200) + "TAIL</body></html>"
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(page))
+		}))
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.restDirectoryURL = server.URL
+
+		err := client.DiscoverRestPrefix("my-org")
+
+		if err == nil {
+			t.Fatal("Expected error for HTML response, got nil")
+		}
+		for _, expected := range []string{"unexpected non-JSON response from REST directory (status 200)", "check org_id and network/proxy", "Corporate Proxy Login"} {
+			if !strings.Contains(err.Error(), expected) {
+				t.Errorf("Expected error to contain %q, got: %v", expected, err)
+			}
+		}
+		if strings.Contains(err.Error(), "TAIL") || strings.Contains(err.Error(), "invalid character") {
+			t.Errorf("Expected only the first 100 bytes and no JSON decoder error, got: %v", err)
+		}
+	})
+}
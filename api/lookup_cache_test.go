@@ -0,0 +1,63 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestLookupCache tests reusing fetched bins and boards for name lookups
+//
+// Acceptance Criteria:
+// - Several bin and board lookups on one client fetch each list only once
+// - Lookups return the same IDs as without the cache
+// - InvalidateCache makes the next lookup fetch again
+func TestLookupCache(t *testing.T) {
+	binRequests, boardRequests := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/bins"):
+			binRequests++
+			w.Write([]byte(`[{"_id": "bin-1", "name": "Doing"}, {"_id": "bin-2", "name": "Done"}]`))
+		case strings.HasPrefix(r.URL.Path, "/boards"):
+			boardRequests++
+			w.Write([]byte(`[{"_id": "board-1", "name": "Sprint", "bins": ["bin-1", "bin-2"]}]`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-key")
+	client.baseURL = server.URL
+
+	t.Run("Given several lookups When resolving names Then each list is fetched once", func(t *testing.T) {
+		for name, want := range map[string]string{"Doing": "bin-1", "done": "bin-2"} {
+			if id, err := client.LookupBinIDByName(name); err != nil || id != want {
+				t.Errorf("Expected %s for %s, got %s (%v)", want, name, id, err)
+			}
+		}
+		for i := 0; i < 2; i++ {
+			if id, err := client.LookupBoardIDByName("sprint"); err != nil || id != "board-1" {
+				t.Errorf("Expected board-1, got %s (%v)", id, err)
+			}
+		}
+		if _, err := client.LookupBinIDByName("Missing"); err == nil || !strings.Contains(err.Error(), "bin not found") {
+			t.Errorf("Expected bin not found, got %v", err)
+		}
+
+		if binRequests != 1 || boardRequests != 1 {
+			t.Errorf("Expected one request per list, got %d bin and %d board requests", binRequests, boardRequests)
+		}
+	})
+
+	t.Run("Given an invalidated cache When looking up again Then the list is fetched again", func(t *testing.T) {
+		client.InvalidateCache()
+
+		if _, err := client.LookupBinIDByName("Doing"); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if binRequests != 2 {
+			t.Errorf("Expected a second bin request, got %d", binRequests)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestMoveTicket tests moving a ticket to another bin
+//
+// Acceptance Criteria:
+// - MoveTicket sends a PATCH to /tickets/{id} whose body holds only bin_id
+// - An empty target bin ID is rejected before any request is made
+func TestMoveTicket(t *testing.T) {
+	t.Run("Given a target bin When moving a ticket Then bin_id is PATCHed", func(t *testing.T) {
+		var body map[string]any
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPatch {
+				t.Errorf("Expected PATCH method, got %s", r.Method)
+			}
+			if r.URL.Path != "/tickets/TICKET-001" {
+				t.Errorf("Expected path /tickets/TICKET-001, got %s", r.URL.Path)
+			}
+			data, _ := io.ReadAll(r.Body)
+			json.Unmarshal(data, &body)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+
+		if err := client.MoveTicket("TICKET-001", "bin-review"); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(body) != 1 || body["bin_id"] != "bin-review" {
+			t.Errorf("Expected body with only bin_id, got %v", body)
+		}
+	})
+
+	t.Run("Given an empty bin ID When moving a ticket Then no request is made", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+		}))
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+
+		if err := client.MoveTicket("TICKET-001", ""); err == nil {
+			t.Error("Expected an error for an empty bin ID")
+		}
+		if requests != 0 {
+			t.Errorf("Expected no requests, got %d", requests)
+		}
+	})
+}
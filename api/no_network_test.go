@@ -0,0 +1,62 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestNoNetworkGuard tests that --no-network makes API calls fail immediately
+//
+// Acceptance Criteria:
+// - Any attempted request fails with "network disabled by --no-network"
+// - No request reaches the server and no retry/backoff happens
+func TestNoNetworkGuard(t *testing.T) {
+	t.Run("Given the network is disabled When fetching data Then it fails fast without a request", func(t *testing.T) {
+		requestCount := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestCount++
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`[]`))
+		}))
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+		client.SetNetworkDisabled(true)
+
+		start := time.Now()
+		_, binsErr := client.GetBins()
+		_, ticketsErr := client.SearchTickets([]string{"user-123"})
+		discoverErr := client.DiscoverRestPrefix("org-123")
+
+		for _, err := range []error{binsErr, ticketsErr, discoverErr} {
+			if !errors.Is(err, ErrNetworkDisabled) {
+				t.Errorf("Expected ErrNetworkDisabled, got %v", err)
+			}
+		}
+		if requestCount != 0 {
+			t.Errorf("Expected no requests, got %d", requestCount)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("Expected to fail fast, took %s", elapsed)
+		}
+	})
+
+	t.Run("Given the network is enabled When fetching data Then the request is made", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`[]`))
+		}))
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+
+		if _, err := client.GetBins(); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	})
+}
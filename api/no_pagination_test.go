@@ -0,0 +1,66 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestPaginationDisabled tests the --no-pagination debug mode
+//
+// Acceptance Criteria:
+// - With pagination disabled, only the first page is fetched
+// - A page-token in the response is ignored
+func TestPaginationDisabled(t *testing.T) {
+	newPagedServer := func(requestCount *int, body string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*requestCount++
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(body))
+		}))
+	}
+
+	t.Run("Given a server returning a page-token When fetching bins with pagination disabled Then only one request is made", func(t *testing.T) {
+		requestCount := 0
+		server := newPagedServer(&requestCount, `{"results": [{"_id": "bin1", "name": "Bin One"}], "page-token": "next"}`)
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+		client.SetPaginationDisabled(true)
+
+		bins, err := client.GetBins()
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if requestCount != 1 {
+			t.Errorf("Expected 1 request, got %d", requestCount)
+		}
+		if len(bins) != 1 {
+			t.Errorf("Expected first page's 1 bin, got %d", len(bins))
+		}
+	})
+
+	t.Run("Given a server returning a page-token When fetching boards with pagination disabled Then only one request is made", func(t *testing.T) {
+		requestCount := 0
+		server := newPagedServer(&requestCount, `{"results": [{"_id": "board1", "name": "Board One"}], "page-token": "next"}`)
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+		client.SetPaginationDisabled(true)
+
+		boards, err := client.GetBoards()
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if requestCount != 1 {
+			t.Errorf("Expected 1 request, got %d", requestCount)
+		}
+		if len(boards) != 1 {
+			t.Errorf("Expected first page's 1 board, got %d", len(boards))
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// TestPageSize tests the configurable max-results page size for bins and boards
+//
+// Acceptance Criteria:
+// - New clients request max-results=1000
+// - SetPageSize lowers the max-results sent by GetBins and GetBoards
+// - Zero or negative page sizes fall back to 100
+func TestPageSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		setSize  bool
+		pageSize int
+		expected int
+	}{
+		{"Given the default page size When listing Then max-results is 1000", false, 0, 1000},
+		{"Given a page size of 100 When listing Then max-results is 100", true, 100, 100},
+		{"Given a page size of 250 When listing Then max-results is 250", true, 250, 250},
+		{"Given a page size of 0 When listing Then max-results falls back to 100", true, 0, 100},
+		{"Given a negative page size When listing Then max-results falls back to 100", true, -5, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requested := map[string]string{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requested[r.URL.Path] = r.URL.Query().Get("max-results")
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"results": []}`))
+			}))
+			defer server.Close()
+
+			client := NewClient("test-key")
+			client.baseURL = server.URL
+			if tt.setSize {
+				client.SetPageSize(tt.pageSize)
+			}
+
+			if _, err := client.GetBins(); err != nil {
+				t.Fatalf("Expected no error fetching bins, got %v", err)
+			}
+			if _, err := client.GetBoards(); err != nil {
+				t.Fatalf("Expected no error fetching boards, got %v", err)
+			}
+
+			expected := strconv.Itoa(tt.expected)
+			for _, path := range []string{"/bins", "/boards"} {
+				if requested[path] != expected {
+					t.Errorf("Expected %s max-results=%s, got %q", path, expected, requested[path])
+				}
+			}
+			if client.PageSize() != tt.expected {
+				t.Errorf("Expected PageSize() = %d, got %d", tt.expected, client.PageSize())
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 )
 
@@ -14,7 +15,7 @@ import (
 //
 // Acceptance Criteria:
 // 1. GetBins() fetches all pages of bins until no more data
-// 2. Uses query parameters: ?max-results=1000 to minimize API calls
+// 2. Uses query parameters: ?max-results=<page size, default 1000> to minimize API calls
 // 3. Follows page-token in responses to get next page
 // 4. Existing tests still pass
 // 5. New tests verify pagination works correctly
@@ -33,8 +34,8 @@ func TestGetBinsPagination(t *testing.T) {
 
 			// Verify max-results parameter is set
 			maxResults := r.URL.Query().Get("max-results")
-			if maxResults != "1000" {
-				t.Errorf("Expected max-results=1000, got %s", maxResults)
+			if maxResults != strconv.Itoa(defaultPageSize) {
+				t.Errorf("Expected max-results=%d, got %s", defaultPageSize, maxResults)
 			}
 
 			requestCount++
@@ -205,6 +206,7 @@ func TestGetBinsPagination(t *testing.T) {
 
 		client := NewClient("test-key")
 		client.baseURL = server.URL
+		client.SetMaxRetries(0)
 
 		// Act
 		bins, err := client.GetBins()
@@ -227,7 +229,7 @@ func TestGetBinsPagination(t *testing.T) {
 //
 // Acceptance Criteria:
 // 1. GetBoards() fetches all pages of boards until no more data
-// 2. Uses query parameters: ?max-results=1000 to minimize API calls
+// 2. Uses query parameters: ?max-results=<page size, default 1000> to minimize API calls
 // 3. Follows page-token in responses to get next page
 // 4. Existing tests still pass
 // 5. New tests verify pagination works correctly
@@ -246,8 +248,8 @@ func TestGetBoardsPagination(t *testing.T) {
 
 			// Verify max-results parameter is set
 			maxResults := r.URL.Query().Get("max-results")
-			if maxResults != "1000" {
-				t.Errorf("Expected max-results=1000, got %s", maxResults)
+			if maxResults != strconv.Itoa(defaultPageSize) {
+				t.Errorf("Expected max-results=%d, got %s", defaultPageSize, maxResults)
 			}
 
 			requestCount++
@@ -406,6 +408,7 @@ func TestGetBoardsPagination(t *testing.T) {
 
 		client := NewClient("test-key")
 		client.baseURL = server.URL
+		client.SetMaxRetries(0)
 
 		// Act
 		boards, err := client.GetBoards()
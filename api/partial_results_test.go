@@ -0,0 +1,110 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestPartialResults tests returning earlier pages when a later pagination page fails
+//
+// Acceptance Criteria:
+// - With partial results allowed, bins from page 1 are returned when page 2 fails
+// - The accompanying error wraps ErrPartialResults
+// - By default a failed page 2 fails the whole call with no bins
+// - A failed first page fails the call even with partial results allowed
+func TestPartialResults(t *testing.T) {
+	newFailingSecondPageServer := func(body string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("page-token") != "" {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(`{"error": "boom"}`))
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(body))
+		}))
+	}
+	const binsPage = `{"results": [{"_id": "bin1", "name": "Bin One"}, {"_id": "bin2", "name": "Bin Two"}], "page-token": "next"}`
+
+	t.Run("Given page 2 fails When fetching bins with partial results allowed Then page 1 bins are returned with a warning", func(t *testing.T) {
+		server := newFailingSecondPageServer(binsPage)
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+		client.SetMaxRetries(0)
+		client.SetPartialResultsAllowed(true)
+
+		bins, err := client.GetBins()
+
+		if !errors.Is(err, ErrPartialResults) {
+			t.Fatalf("Expected error wrapping ErrPartialResults, got %v", err)
+		}
+		if len(bins) != 2 || bins[0].ID != "bin1" || bins[1].ID != "bin2" {
+			t.Errorf("Expected page 1 bins bin1 and bin2, got %+v", bins)
+		}
+	})
+
+	t.Run("Given page 2 fails When fetching bins by default Then the call fails with no bins", func(t *testing.T) {
+		server := newFailingSecondPageServer(binsPage)
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+		client.SetMaxRetries(0)
+
+		bins, err := client.GetBins()
+
+		if err == nil {
+			t.Fatal("Expected an error, got nil")
+		}
+		if errors.Is(err, ErrPartialResults) {
+			t.Errorf("Expected a hard failure, got partial results warning: %v", err)
+		}
+		if bins != nil {
+			t.Errorf("Expected no bins, got %+v", bins)
+		}
+	})
+
+	t.Run("Given page 2 fails When fetching boards with partial results allowed Then page 1 boards are returned with a warning", func(t *testing.T) {
+		server := newFailingSecondPageServer(`{"results": [{"_id": "board1", "name": "Board One"}], "page-token": "next"}`)
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+		client.SetMaxRetries(0)
+		client.SetPartialResultsAllowed(true)
+
+		boards, err := client.GetBoards()
+
+		if !errors.Is(err, ErrPartialResults) {
+			t.Fatalf("Expected error wrapping ErrPartialResults, got %v", err)
+		}
+		if len(boards) != 1 || boards[0].ID != "board1" {
+			t.Errorf("Expected page 1 board board1, got %+v", boards)
+		}
+	})
+
+	t.Run("Given the first page fails When fetching bins with partial results allowed Then the call fails with no bins", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+		client.SetMaxRetries(0)
+		client.SetPartialResultsAllowed(true)
+
+		bins, err := client.GetBins()
+
+		if err == nil || errors.Is(err, ErrPartialResults) {
+			t.Errorf("Expected a hard failure, got %v", err)
+		}
+		if bins != nil {
+			t.Errorf("Expected no bins, got %+v", bins)
+		}
+	})
+}
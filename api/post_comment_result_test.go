@@ -0,0 +1,100 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestPostCommentWithResult tests reporting the status and comment ID of a posted comment
+//
+// Acceptance Criteria:
+// - The response status code is returned, so 201 and 204 can be told apart
+// - A comment _id in the response body is surfaced
+// - 200 and 201 confirm the comment; 202 and 204 do not
+// - An empty or non-JSON body leaves the ID empty without failing
+// - PostComment still posts the payload and only reports errors
+func TestPostCommentWithResult(t *testing.T) {
+	newServer := func(status int, body string, received *string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if received != nil {
+				data, _ := io.ReadAll(r.Body)
+				*received = string(data)
+			}
+			w.WriteHeader(status)
+			w.Write([]byte(body))
+		}))
+	}
+	payload := models.CommentPayload{ID: "c1", TicketID: "t1", Comment: "Done"}
+
+	t.Run("Given a 201 with the created comment When posting Then the status and ID are returned", func(t *testing.T) {
+		server := newServer(http.StatusCreated, `{"_id": "c1-saved", "comment": "Done"}`, nil)
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+
+		result, err := client.PostCommentWithResult(payload)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if result.StatusCode != http.StatusCreated || result.ID != "c1-saved" || !result.Confirmed() {
+			t.Errorf("Expected a confirmed 201 with ID c1-saved, got %+v", result)
+		}
+	})
+
+	t.Run("Given a 204 with no body When posting Then the status is returned unconfirmed with no ID", func(t *testing.T) {
+		server := newServer(http.StatusNoContent, "", nil)
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+
+		result, err := client.PostCommentWithResult(payload)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if result.StatusCode != http.StatusNoContent || result.ID != "" || result.Confirmed() {
+			t.Errorf("Expected an unconfirmed 204 without ID, got %+v", result)
+		}
+	})
+
+	t.Run("Given a 200 with a non-JSON body When posting Then it is confirmed with no ID", func(t *testing.T) {
+		server := newServer(http.StatusOK, "ok", nil)
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+
+		result, err := client.PostCommentWithResult(payload)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !result.Confirmed() || result.ID != "" {
+			t.Errorf("Expected a confirmed 200 without ID, got %+v", result)
+		}
+	})
+
+	t.Run("Given a server When posting with PostComment Then the payload is sent", func(t *testing.T) {
+		var received string
+		server := newServer(http.StatusCreated, "", &received)
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+
+		if err := client.PostComment(payload); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !strings.Contains(received, `"comment":"Done"`) {
+			t.Errorf("Expected the comment in the request body, got %q", received)
+		}
+	})
+}
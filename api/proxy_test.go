@@ -0,0 +1,73 @@
+package api
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// TestProxy tests routing API requests through an HTTP proxy
+//
+// Acceptance Criteria:
+// - Requests go through the proxy chosen by SetProxy
+// - Under --debug, the proxy used for each host is logged once, with credentials redacted
+// - Without a proxy, the debug line says the connection is direct
+func TestProxy(t *testing.T) {
+	t.Run("Given a proxy When requesting Then the request goes through it and is logged once", func(t *testing.T) {
+		var proxiedHosts []string
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			proxiedHosts = append(proxiedHosts, r.Host)
+			w.Write([]byte(`[{"_id": "bin1", "name": "Doing"}]`))
+		}))
+		defer proxy.Close()
+
+		proxyURL, _ := url.Parse(proxy.URL)
+		proxyURL.User = url.UserPassword("user", "secret")
+		var debug bytes.Buffer
+		client := NewClient("test-key")
+		client.baseURL = "http://flowboards.invalid"
+		client.SetProxy(http.ProxyURL(proxyURL))
+		client.SetDebugOutput(&debug)
+
+		for i := 0; i < 2; i++ {
+			if _, err := client.GetBins(); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			client.InvalidateCache()
+		}
+
+		if len(proxiedHosts) != 2 || proxiedHosts[0] != "flowboards.invalid" {
+			t.Errorf("Expected 2 proxied requests for flowboards.invalid, got %v", proxiedHosts)
+		}
+		if got := strings.Count(debug.String(), "using proxy"); got != 1 {
+			t.Errorf("Expected 1 proxy debug line, got %d:\n%s", got, debug.String())
+		}
+		if strings.Contains(debug.String(), "secret") || !strings.Contains(debug.String(), "for flowboards.invalid") {
+			t.Errorf("Expected a redacted proxy line naming the host, got:\n%s", debug.String())
+		}
+	})
+
+	t.Run("Given no proxy When requesting under debug Then a direct connection is logged", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`[]`))
+		}))
+		defer server.Close()
+
+		var debug bytes.Buffer
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+		client.SetProxy(nil)
+		client.SetDebugOutput(&debug)
+
+		if _, err := client.GetBins(); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if !strings.Contains(debug.String(), "no proxy for") {
+			t.Errorf("Expected a direct connection debug line, got:\n%s", debug.String())
+		}
+	})
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"errors"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestRateLimiting tests the handling of 429 Too Many Requests responses
+//
+// Acceptance Criteria:
+// - A 429 followed by a 200 succeeds after one retry
+// - Without a Retry-After header the client backs off 1s
+// - A Retry-After longer than the max wait fails immediately with ErrRateLimited
+// - Still being rate limited after one retry fails with ErrRateLimited, without further retries
+func TestRateLimiting(t *testing.T) {
+	newClient := func(serverURL string, delays *[]time.Duration) *Client {
+		client := NewClient("test-key")
+		client.baseURL = serverURL
+		client.SetRetryTiming(func(d time.Duration) { *delays = append(*delays, d) }, rand.NewSource(1))
+		return client
+	}
+
+	t.Run("Given a 429 once then a 200 When fetching Then the retry succeeds after a 1s backoff", func(t *testing.T) {
+		requestCount := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestCount++
+			if requestCount == 1 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"results": [{"_id": "bin1", "name": "Bin One"}]}`))
+		}))
+		defer server.Close()
+
+		var delays []time.Duration
+		bins, err := newClient(server.URL, &delays).GetBins()
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(bins) != 1 || requestCount != 2 {
+			t.Errorf("Expected 1 bin after 2 requests, got %d bins after %d requests", len(bins), requestCount)
+		}
+		if len(delays) != 1 || delays[0] != defaultRateLimitBackoff {
+			t.Errorf("Expected a single %s wait, got %v", defaultRateLimitBackoff, delays)
+		}
+	})
+
+	t.Run("Given a Retry-After beyond the max wait When fetching Then fail with ErrRateLimited without waiting", func(t *testing.T) {
+		requestCount := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestCount++
+			w.Header().Set("Retry-After", "120")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		var delays []time.Duration
+		client := newClient(server.URL, &delays)
+		client.SetMaxRateLimitWait(10 * time.Second)
+		_, err := client.GetBins()
+
+		if !errors.Is(err, ErrRateLimited) {
+			t.Errorf("Expected ErrRateLimited, got %v", err)
+		}
+		if requestCount != 1 || len(delays) != 0 {
+			t.Errorf("Expected 1 request and no waits, got %d requests and waits %v", requestCount, delays)
+		}
+	})
+
+	t.Run("Given every request is rate limited When fetching Then fail with ErrRateLimited after one retry", func(t *testing.T) {
+		requestCount := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestCount++
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		var delays []time.Duration
+		_, err := newClient(server.URL, &delays).GetBins()
+
+		if !errors.Is(err, ErrRateLimited) {
+			t.Errorf("Expected ErrRateLimited, got %v", err)
+		}
+		if requestCount != 2 || len(delays) != 1 {
+			t.Errorf("Expected exactly one retry, got %d requests and waits %v", requestCount, delays)
+		}
+	})
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestRequestStats tests the per-client request counters shown under --debug
+//
+// Acceptance Criteria:
+// - A client that made no requests reports all-zero counters
+// - Every request is counted, including retries and paginated pages
+// - Response body bytes are totalled and HTTP time accumulates
+func TestRequestStats(t *testing.T) {
+	t.Run("Given a new client When reading stats Then all counters are zero", func(t *testing.T) {
+		if stats := NewClient("test-key").Stats(); stats != (RequestStats{}) {
+			t.Errorf("Expected zero stats, got %+v", stats)
+		}
+	})
+
+	t.Run("Given a retried request and a second page When fetching bins Then every request is counted", func(t *testing.T) {
+		pages := []string{
+			`{"results": [{"_id": "bin1", "name": "One"}], "page-token": "next"}`,
+			`{"results": [{"_id": "bin2", "name": "Two"}]}`,
+		}
+		requestCount := 0
+		received := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestCount++
+			if requestCount == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			body := pages[0]
+			if r.URL.Query().Get("page-token") != "" {
+				body = pages[1]
+			}
+			received += len(body)
+			w.Write([]byte(body))
+		}))
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+		client.SetRetryTiming(func(time.Duration) {}, rand.NewSource(1))
+
+		if _, err := client.GetBins(); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		stats := client.Stats()
+		if stats.Calls != 3 {
+			t.Errorf("Expected 3 calls, got %d", stats.Calls)
+		}
+		if stats.BytesReceived != int64(received) {
+			t.Errorf("Expected %d bytes received, got %d", received, stats.BytesReceived)
+		}
+		if stats.HTTPTime <= 0 {
+			t.Errorf("Expected HTTP time to accumulate, got %s", stats.HTTPTime)
+		}
+	})
+
+	t.Run("Given two sets of counters When adding Then each counter is summed", func(t *testing.T) {
+		sum := RequestStats{Calls: 1, BytesReceived: 10, HTTPTime: time.Second}.Add(RequestStats{Calls: 2, BytesReceived: 5, HTTPTime: time.Second})
+
+		if sum != (RequestStats{Calls: 3, BytesReceived: 15, HTTPTime: 2 * time.Second}) {
+			t.Errorf("Unexpected sum %+v", sum)
+		}
+	})
+}
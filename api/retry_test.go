@@ -0,0 +1,258 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestRetryDeterminism tests that retry backoff can be made instantaneous and deterministic
+//
+// Acceptance Criteria:
+// - A no-op sleeper and fixed random source can be injected into the client
+// - Transient failures are retried exactly maxRetries times
+// - Backoff delays grow exponentially with jitter, and are identical for the same seed
+// - Total wall time stays negligible with the no-op sleeper
+func TestRetryDeterminism(t *testing.T) {
+	newFailingServer := func(requestCount *int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*requestCount++
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("Service Unavailable"))
+		}))
+	}
+
+	runWithSeed := func(t *testing.T, seed int64) (int, []time.Duration) {
+		requestCount := 0
+		server := newFailingServer(&requestCount)
+		defer server.Close()
+
+		var delays []time.Duration
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+		client.SetRetryTiming(func(d time.Duration) { delays = append(delays, d) }, rand.NewSource(seed))
+
+		if _, err := client.GetBins(); err == nil {
+			t.Fatal("Expected error after exhausting retries, got nil")
+		}
+		return requestCount, delays
+	}
+
+	t.Run("Given a persistently unavailable API When fetching Then the request is retried exactly maxRetries times", func(t *testing.T) {
+		start := time.Now()
+		requestCount, delays := runWithSeed(t, 42)
+		elapsed := time.Since(start)
+
+		if requestCount != defaultMaxRetries+1 {
+			t.Errorf("Expected %d requests, got %d", defaultMaxRetries+1, requestCount)
+		}
+		if len(delays) != defaultMaxRetries {
+			t.Errorf("Expected %d backoff waits, got %d", defaultMaxRetries, len(delays))
+		}
+		if elapsed > time.Second {
+			t.Errorf("Expected negligible wall time with a no-op sleeper, took %s", elapsed)
+		}
+	})
+
+	t.Run("Given backoff delays When retrying Then each grows exponentially within its jitter range", func(t *testing.T) {
+		_, delays := runWithSeed(t, 42)
+
+		for attempt, delay := range delays {
+			base := initialBackoff << attempt
+			if delay < base || delay >= 2*base {
+				t.Errorf("Attempt %d: expected delay in [%s, %s), got %s", attempt, base, 2*base, delay)
+			}
+		}
+	})
+
+	t.Run("Given the same random seed When retrying twice Then the delays are identical", func(t *testing.T) {
+		_, first := runWithSeed(t, 7)
+		_, second := runWithSeed(t, 7)
+
+		for i := range first {
+			if first[i] != second[i] {
+				t.Errorf("Expected identical delays for the same seed, got %v and %v", first, second)
+				break
+			}
+		}
+	})
+
+	t.Run("Given a transient failure followed by success When fetching Then the result is returned", func(t *testing.T) {
+		requestCount := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestCount++
+			if requestCount == 1 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`[{"_id": "bin1", "name": "Bin One"}]`))
+		}))
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+		client.SetRetryTiming(func(time.Duration) {}, rand.NewSource(1))
+
+		bins, err := client.GetBins()
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(bins) != 1 || requestCount != 2 {
+			t.Errorf("Expected 1 bin after 2 requests, got %d bins after %d requests", len(bins), requestCount)
+		}
+	})
+}
+
+// TestRetryPolicy tests which failures are retried and how long the client waits
+//
+// Acceptance Criteria:
+// - 429 and 5xx gateway/server failures are retried
+// - A Retry-After header on a 429 replaces the computed backoff, and a 429 is retried only once
+// - Client errors such as 400, 401, 403, and 404 fail fast without retrying
+// - Connection resets are retried
+// - Setting the retry count to zero disables retries
+// - Writes such as posting a comment or updating a ticket are never retried
+func TestRetryPolicy(t *testing.T) {
+	newServer := func(requestCount *int, status int, header map[string]string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*requestCount++
+			for key, value := range header {
+				w.Header().Set(key, value)
+			}
+			w.WriteHeader(status)
+		}))
+	}
+
+	newClient := func(serverURL string, delays *[]time.Duration) *Client {
+		client := NewClient("test-key")
+		client.baseURL = serverURL
+		client.SetRetryTiming(func(d time.Duration) { *delays = append(*delays, d) }, rand.NewSource(1))
+		return client
+	}
+
+	t.Run("Given a 500 response When fetching Then the request is retried", func(t *testing.T) {
+		requestCount := 0
+		server := newServer(&requestCount, http.StatusInternalServerError, nil)
+		defer server.Close()
+
+		var delays []time.Duration
+		newClient(server.URL, &delays).GetBins()
+
+		if requestCount != defaultMaxRetries+1 {
+			t.Errorf("Expected %d requests, got %d", defaultMaxRetries+1, requestCount)
+		}
+	})
+
+	t.Run("Given a 429 with Retry-After When fetching Then the client waits as requested", func(t *testing.T) {
+		requestCount := 0
+		server := newServer(&requestCount, http.StatusTooManyRequests, map[string]string{"Retry-After": "2"})
+		defer server.Close()
+
+		var delays []time.Duration
+		newClient(server.URL, &delays).GetBins()
+
+		if requestCount != 2 {
+			t.Errorf("Expected 2 requests, got %d", requestCount)
+		}
+		if len(delays) != 1 || delays[0] != 2*time.Second {
+			t.Errorf("Expected a single 2s wait, got %v", delays)
+		}
+	})
+
+	t.Run("Given client errors When fetching Then they fail fast without retrying", func(t *testing.T) {
+		for _, status := range []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound} {
+			requestCount := 0
+			server := newServer(&requestCount, status, nil)
+
+			var delays []time.Duration
+			if _, err := newClient(server.URL, &delays).GetBins(); err == nil {
+				t.Errorf("Status %d: expected an error", status)
+			}
+			if requestCount != 1 || len(delays) != 0 {
+				t.Errorf("Status %d: expected 1 request and no waits, got %d requests and %d waits", status, requestCount, len(delays))
+			}
+			server.Close()
+		}
+	})
+
+	t.Run("Given retries set to zero When a transient failure occurs Then only one request is made", func(t *testing.T) {
+		requestCount := 0
+		server := newServer(&requestCount, http.StatusServiceUnavailable, nil)
+		defer server.Close()
+
+		var delays []time.Duration
+		client := newClient(server.URL, &delays)
+		client.SetMaxRetries(0)
+		client.GetBins()
+
+		if requestCount != 1 {
+			t.Errorf("Expected 1 request, got %d", requestCount)
+		}
+	})
+
+	t.Run("Given a 503 response When posting a comment Then the request is not retried", func(t *testing.T) {
+		requestCount := 0
+		server := newServer(&requestCount, http.StatusServiceUnavailable, nil)
+		defer server.Close()
+
+		var delays []time.Duration
+		err := newClient(server.URL, &delays).PostComment(models.CommentPayload{ID: "comment-1", TicketID: "ticket-1", Comment: "Done"})
+
+		if err == nil {
+			t.Error("Expected an error for the 503 response")
+		}
+		if requestCount != 1 || len(delays) != 0 {
+			t.Errorf("Expected 1 request and no waits, got %d requests and %d waits", requestCount, len(delays))
+		}
+	})
+
+	t.Run("Given a 503 response When updating a ticket Then the request is not retried", func(t *testing.T) {
+		requestCount := 0
+		server := newServer(&requestCount, http.StatusServiceUnavailable, nil)
+		defer server.Close()
+
+		var delays []time.Duration
+		err := newClient(server.URL, &delays).UpdateTicket("ticket-1", map[string]any{"bin_id": "bin-2"})
+
+		if err == nil {
+			t.Error("Expected an error for the 503 response")
+		}
+		if requestCount != 1 || len(delays) != 0 {
+			t.Errorf("Expected 1 request and no waits, got %d requests and %d waits", requestCount, len(delays))
+		}
+	})
+
+	t.Run("Given a connection reset When classifying the failure Then it is retryable", func(t *testing.T) {
+		err := fmt.Errorf("request failed: %w", &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET})
+
+		if !isRetryable(0, err) {
+			t.Error("Expected a connection reset to be retryable")
+		}
+		if isRetryable(0, errors.New("no such host")) {
+			t.Error("Expected other transport errors not to be retried")
+		}
+	})
+
+	t.Run("Given Retry-After values When parsing Then seconds and HTTP dates are supported", func(t *testing.T) {
+		now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+		if got := parseRetryAfter("5", now); got != 5*time.Second {
+			t.Errorf("Expected 5s, got %s", got)
+		}
+		if got := parseRetryAfter(now.Add(90*time.Second).Format(http.TimeFormat), now); got != 90*time.Second {
+			t.Errorf("Expected 90s, got %s", got)
+		}
+		if got := parseRetryAfter("soon", now); got != 0 {
+			t.Errorf("Expected 0 for an invalid header, got %s", got)
+		}
+	})
+}
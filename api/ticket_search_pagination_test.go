@@ -0,0 +1,71 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestSearchTicketsPagination tests following page-token in ticket search
+//
+// Acceptance Criteria:
+// - A {results, page-token} response is followed until no token is returned
+// - Every page keeps the original filters and adds the page-token
+// - A bare array is a single page, as before
+func TestSearchTicketsPagination(t *testing.T) {
+	t.Run("Given tickets across two pages When searching Then all tickets are returned", func(t *testing.T) {
+		requestCount := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestCount++
+			if r.URL.Path != "/ticket-search" || r.URL.Query().Get("users") != "user-1" {
+				t.Errorf("Unexpected request %s", r.URL)
+			}
+
+			switch r.URL.Query().Get("page-token") {
+			case "":
+				w.Write([]byte(`{"results": [{"_id": "T1", "name": "One"}, {"_id": "T2", "name": "Two"}], "page-token": "next-page"}`))
+			case "next-page":
+				w.Write([]byte(`{"results": [{"_id": "T3", "name": "Three"}]}`))
+			default:
+				t.Errorf("Unexpected page-token %q", r.URL.Query().Get("page-token"))
+			}
+		}))
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+
+		tickets, err := client.SearchTickets([]string{"user-1"})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(tickets) != 3 || tickets[0].ID != "T1" || tickets[2].ID != "T3" {
+			t.Errorf("Expected T1, T2, T3, got %+v", tickets)
+		}
+		if requestCount != 2 {
+			t.Errorf("Expected 2 requests, got %d", requestCount)
+		}
+	})
+
+	t.Run("Given a bare array When searching Then a single request is made", func(t *testing.T) {
+		requestCount := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestCount++
+			w.Write([]byte(`[{"_id": "T1", "name": "One"}]`))
+		}))
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+
+		tickets, err := client.SearchTickets([]string{"user-1"})
+
+		if err != nil || len(tickets) != 1 {
+			t.Fatalf("Expected one ticket, got %v (%v)", tickets, err)
+		}
+		if requestCount != 1 {
+			t.Errorf("Expected 1 request, got %d", requestCount)
+		}
+	})
+}
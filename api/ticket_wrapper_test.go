@@ -0,0 +1,77 @@
+package api
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestTicketResponseWrapperShapes tests parsing ticket search responses wrapped under different keys
+//
+// Acceptance Criteria:
+// - {"tickets": [...]}, {"results": [...]}, {"data": [...]} and a bare array parse to the same tickets
+// - The detected shape is reported on the debug output
+func TestTicketResponseWrapperShapes(t *testing.T) {
+	ticketsJSON := `[
+		{"_id": "TICKET-001", "name": "First", "bin_name": "To Do"},
+		{"_id": "TICKET-002", "name": "Second", "bin_name": "Done"}
+	]`
+
+	tests := []struct {
+		name          string
+		body          string
+		expectedShape string
+	}{
+		{"Given a bare array When searching tickets Then tickets are parsed", ticketsJSON, "bare array"},
+		{"Given a tickets wrapper When searching tickets Then tickets are parsed", `{"tickets": ` + ticketsJSON + `}`, `"tickets" key`},
+		{"Given a results wrapper When searching tickets Then tickets are parsed", `{"results": ` + ticketsJSON + `, "page-token": ""}`, `"results" key`},
+		{"Given a data wrapper When searching tickets Then tickets are parsed", `{"data": ` + ticketsJSON + `}`, `"data" key`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			var debug bytes.Buffer
+			client := NewClient("test-auth-key")
+			client.baseURL = server.URL
+			client.SetDebugOutput(&debug)
+
+			tickets, err := client.SearchTickets([]string{"user-123"})
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+
+			if len(tickets) != 2 {
+				t.Fatalf("Expected 2 tickets, got %d", len(tickets))
+			}
+			if tickets[0].ID != "TICKET-001" || tickets[1].Name != "Second" || tickets[1].BinName != "Done" {
+				t.Errorf("Unexpected tickets: %+v", tickets)
+			}
+			if !strings.Contains(debug.String(), tt.expectedShape) {
+				t.Errorf("Expected debug output to mention %q, got: %q", tt.expectedShape, debug.String())
+			}
+		})
+	}
+
+	t.Run("Given an object without a known key When searching tickets Then an error is returned", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"items": []}`))
+		}))
+		defer server.Close()
+
+		client := NewClient("test-auth-key")
+		client.baseURL = server.URL
+
+		if _, err := client.SearchTickets([]string{"user-123"}); err == nil {
+			t.Error("Expected error for unknown wrapper key, got nil")
+		}
+	})
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestClientTimeout tests the configurable request timeout
+//
+// Acceptance Criteria:
+// - NewClient keeps the 30-second default
+// - NewClientWithTimeout fails a request that takes longer than the timeout
+// - A zero or negative timeout means no timeout
+func TestClientTimeout(t *testing.T) {
+	t.Run("Given NewClient When checking the timeout Then it is 30 seconds", func(t *testing.T) {
+		if got := NewClient("key").Timeout(); got != 30*time.Second {
+			t.Errorf("Expected 30s, got %s", got)
+		}
+	})
+
+	t.Run("Given a short timeout and a slow server When requesting Then the request fails", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(200 * time.Millisecond)
+			w.Write([]byte(`{}`))
+		}))
+		defer server.Close()
+
+		client := NewClientWithTimeout("key", 20*time.Millisecond)
+		client.SetMaxRetries(0)
+		if _, err := client.doRequestWithoutBase(context.Background(), "GET", server.URL, nil); err == nil {
+			t.Error("Expected a timeout error")
+		}
+	})
+
+	t.Run("Given a zero or negative timeout When creating the client Then there is no timeout", func(t *testing.T) {
+		for _, timeout := range []time.Duration{0, -time.Second} {
+			if got := NewClientWithTimeout("key", timeout).Timeout(); got != 0 {
+				t.Errorf("Expected no timeout for %s, got %s", timeout, got)
+			}
+		}
+	})
+}
@@ -0,0 +1,96 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestUpdateTicket tests editing a ticket's name and description
+//
+// User Story:
+// As a user, I want to update a ticket's name or description from the CLI,
+// so that I can fix typos without opening Flow Boards.
+//
+// Acceptance Criteria:
+// - UpdateTicket sends a PATCH to /tickets/{id}
+// - Only the provided fields appear in the request body
+// - An empty field set is rejected before any request is made
+func TestUpdateTicket(t *testing.T) {
+	t.Run("Given only a name When updating a ticket Then only name is sent in the PATCH body", func(t *testing.T) {
+		var body map[string]any
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPatch {
+				t.Errorf("Expected PATCH method, got %s", r.Method)
+			}
+			if r.URL.Path != "/tickets/TICKET-001" {
+				t.Errorf("Expected path /tickets/TICKET-001, got %s", r.URL.Path)
+			}
+			data, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(data, &body); err != nil {
+				t.Errorf("Expected JSON body, got %s", string(data))
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+
+		err := client.UpdateTicket("TICKET-001", map[string]any{"name": "New name"})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(body) != 1 || body["name"] != "New name" {
+			t.Errorf("Expected body with only name, got %v", body)
+		}
+		if _, ok := body["description"]; ok {
+			t.Error("Expected description to be omitted from the body")
+		}
+	})
+
+	t.Run("Given name and description When updating a ticket Then both fields are sent", func(t *testing.T) {
+		var body map[string]any
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			data, _ := io.ReadAll(r.Body)
+			json.Unmarshal(data, &body)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+
+		err := client.UpdateTicket("TICKET-001", map[string]any{"name": "New name", "description": "New desc"})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(body) != 2 || body["name"] != "New name" || body["description"] != "New desc" {
+			t.Errorf("Expected body with name and description, got %v", body)
+		}
+	})
+
+	t.Run("Given no fields When updating a ticket Then return error without making a request", func(t *testing.T) {
+		requestCount := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestCount++
+		}))
+		defer server.Close()
+
+		client := NewClient("test-key")
+		client.baseURL = server.URL
+
+		err := client.UpdateTicket("TICKET-001", map[string]any{})
+
+		if err == nil {
+			t.Error("Expected error for empty field set, got nil")
+		}
+		if requestCount != 0 {
+			t.Errorf("Expected no requests, got %d", requestCount)
+		}
+	})
+}
@@ -1,25 +1,72 @@
 package config
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
+	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
 const (
-	configDir      = ".fb"
-	configFileName = "config.yaml"
-	configDirPerm  = 0700 // User-only access for security (Story 5.1)
-	configFilePerm = 0600
+	configDir          = ".fb"
+	configFileName     = "config.yaml"
+	repoConfigFileName = ".fb.yaml"
+	configDirPerm      = 0700 // User-only access for security (Story 5.1)
+	configFilePerm     = 0600
+)
+
+// Environment variables that override the corresponding config file fields
+const (
+	envAuthKey   = "FB_AUTH_KEY"
+	envOrgID     = "FB_ORG_ID"
+	envUserEmail = "FB_USER_EMAIL"
+
+	// envConfigDir names the directory holding config.yaml when the home directory cannot
+	// be determined, e.g. in minimal containers without HOME
+	envConfigDir = "FB_CONFIG_DIR"
+)
+
+// Views accepted by default_view
+const (
+	ViewMinimal = "minimal"
+	ViewTable   = "table"
+	ViewVerbose = "verbose"
+	ViewJSON    = "json"
+)
+
+// Bounds for wrap_width and --width: narrower leaves no room for text after the field
+// labels, and wider is almost certainly a typo
+const (
+	MinWrapWidth = 20
+	MaxWrapWidth = 1000
 )
 
 // Validation error messages
 const (
-	errAuthKeyRequired   = "auth_key is required in config file"
-	errOrgIDRequired     = "org_id is required in config file"
-	errUserEmailRequired = "user_email is required in config file"
+	errAuthKeyRequired       = "auth_key is required in config file"
+	errOrgIDRequired         = "org_id is required in config file"
+	errUserEmailRequired     = "user_email is required in config file"
+	errUserEmailMalformed    = "user_email '%s' looks malformed: expected an address like you@example.com"
+	errDueSoonNegative       = "due_soon_hours must not be negative"
+	errLargeFetchNegative    = "large_fetch_threshold must not be negative"
+	errProfileNotFound       = "profile '%s' not found in config file (available: %s)"
+	errDefaultViewUnknown    = "unknown default_view '%s' in config file (valid: minimal, table, verbose, json)"
+	errNoConfigDir           = "cannot find the config directory: set HOME, or set FB_CONFIG_DIR to the directory holding config.yaml"
+	errRepoConfigKeys        = "%s may only set org_id and rest_directory_url; move %s to ~/.fb/config.yaml"
+	errRepoConfigInsecureURL = "%s: rest_directory_url must use https, got %s"
+	errKeyNotEditable        = "unknown config key '%s' (settable keys: %s)"
+	errEmptyValue            = "%s cannot be empty"
+	errWrapWidthRange        = "wrap_width must be between %d and %d columns, got %d"
+	errAuthKeyNeedsQuotes    = "auth_key on line %d must be quoted because %s, which YAML would misread; write it as auth_key: \"your-key\""
 )
 
 // Config represents the application configuration
@@ -27,15 +74,95 @@ type Config struct {
 	AuthKey   string `yaml:"auth_key"`
 	OrgID     string `yaml:"org_id"`
 	UserEmail string `yaml:"user_email"`
+
+	// RestDirectoryURL overrides the REST directory used to discover the API endpoint
+	RestDirectoryURL string `yaml:"rest_directory_url,omitempty"`
+
+	// WebBaseURL is the Flow Boards web app URL used by fb open; when empty it is derived
+	// from the discovered REST prefix
+	WebBaseURL string `yaml:"web_base_url,omitempty"`
+
+	// DueSoonHours overrides the window used to flag tickets as due soon in `fb summary`
+	DueSoonHours int `yaml:"due_soon_hours,omitempty"`
+
+	// LargeFetchThreshold overrides the ticket count above which an unfiltered fetch
+	// prints a hint suggesting --bin
+	LargeFetchThreshold int `yaml:"large_fetch_threshold,omitempty"`
+
+	// TimeoutSeconds overrides the 30-second API request timeout; zero or negative means
+	// no timeout. Nil when unset so an explicit zero can be told apart from the default.
+	TimeoutSeconds *int `yaml:"timeout_seconds,omitempty"`
+
+	// PageSize overrides the max-results of 1000 requested per page of bins and boards,
+	// for backends that cap it lower; zero or negative values fall back to 100
+	PageSize int `yaml:"page_size,omitempty"`
+
+	// RateLimitMaxWaitSeconds overrides the 30-second limit on how long a rate-limited (429)
+	// request waits before retrying; negative means never wait
+	RateLimitMaxWaitSeconds int `yaml:"rate_limit_max_wait_seconds,omitempty"`
+
+	// WrapWidth sets the column width descriptions wrap to, overriding terminal detection and
+	// the 80-column default; --width overrides it in turn
+	WrapWidth int `yaml:"wrap_width,omitempty"`
+
+	// Theme selects a color preset (dark, light, none) and Colors overrides individual
+	// elements (id, bin, overdue) with a color name
+	Theme  string            `yaml:"theme,omitempty"`
+	Colors map[string]string `yaml:"colors,omitempty"`
+
+	// HiddenBins lists bins, by name or ID, whose tickets the list leaves out unless --all
+	// is given, e.g. Done or Archived
+	HiddenBins []string `yaml:"hidden_bins,omitempty"`
+
+	// DefaultView selects the ticket list layout (minimal, table, verbose, json) used when
+	// no view flag is given; empty means minimal
+	DefaultView string `yaml:"default_view,omitempty"`
+
+	// Profiles holds named credentials for working across several orgs. The profile chosen
+	// with --profile, or else DefaultProfile, overrides the top-level credentials.
+	Profiles       map[string]Profile `yaml:"profiles,omitempty"`
+	DefaultProfile string             `yaml:"default_profile,omitempty"`
+
+	// Runtime options set from command-line flags; never read from or written to the config file
+	ActiveProfile string `yaml:"-"` // Name of the profile applied by LoadConfig, if any
+	NoPagination  bool   `yaml:"-"`
+	Debug         bool   `yaml:"-"`
+	NoNetwork     bool   `yaml:"-"`
+	RefreshPrefix bool   `yaml:"-"` // Rediscover the REST prefix instead of using the cached one
+	RefreshUser   bool   `yaml:"-"` // Look up the user ID instead of using the cached one
+}
+
+// Profile is one named set of credentials under profiles:
+type Profile struct {
+	AuthKey   string `yaml:"auth_key,omitempty"`
+	OrgID     string `yaml:"org_id,omitempty"`
+	UserEmail string `yaml:"user_email,omitempty"`
 }
 
 // GetConfigPath returns the path to the config file
 func GetConfigPath() (string, error) {
-	home, err := os.UserHomeDir()
+	dir, err := ConfigDir()
 	if err != nil {
-		return "", fmt.Errorf("failed to get home directory: %w", err)
+		return "", err
 	}
-	return filepath.Join(home, configDir, configFileName), nil
+	return filepath.Join(dir, configFileName), nil
+}
+
+// ConfigDir returns the directory holding config.yaml: ~/.fb, or FB_CONFIG_DIR when the
+// home directory cannot be determined
+func ConfigDir() (string, error) {
+	if home, err := os.UserHomeDir(); err == nil {
+		return filepath.Join(home, configDir), nil
+	}
+	return fallbackConfigDir()
+}
+
+// fallbackConfigDir returns FB_CONFIG_DIR, or an error saying how to locate the config
+func fallbackConfigDir() (string, error) {
+	if dir := os.Getenv(envConfigDir); dir != "" {
+		return dir, nil
+	}
+	return "", fmt.Errorf(errNoConfigDir)
 }
 
 // LoadConfigFromPath reads configuration from a specific path
@@ -48,8 +175,18 @@ func LoadConfigFromPath(configPath string) (*Config, error) {
 		return nil, fmt.Errorf("failed to read config file: %w", err)
 	}
 
+	if err := checkAuthKeyQuoting(data); err != nil {
+		return nil, fmt.Errorf("%s: %w", configPath, err)
+	}
+
 	var cfg Config
-	if err := yaml.Unmarshal(data, &cfg); err != nil {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&cfg); err != nil && err != io.EOF {
+		if keys := unknownKeys(err); len(keys) > 0 {
+			return nil, fmt.Errorf("unknown key(s) in %s: %s (check for typos, e.g. auth_key, org_id, user_email)",
+				configPath, strings.Join(keys, ", "))
+		}
 		// Story 5.3: Enhance YAML syntax errors with helpful guidance
 		return nil, EnhanceYAMLError(err)
 	}
@@ -57,6 +194,245 @@ func LoadConfigFromPath(configPath string) (*Config, error) {
 	return &cfg, nil
 }
 
+// authKeyLinePattern matches an auth_key line, top-level or under a profile, capturing its value
+var authKeyLinePattern = regexp.MustCompile(`^\s*auth_key:\s*(.*?)\s*$`)
+
+// yamlIndicators are characters that YAML gives a special meaning at the start of an unquoted
+// value: anchors, aliases, tags, comments, and reserved characters
+const yamlIndicators = "&*!#%@`"
+
+// checkAuthKeyQuoting catches unquoted auth_key values that YAML would silently cut short or
+// fail on with a generic syntax error, such as a key starting with '&' or containing ': ', or
+// containing ' #', where YAML starts a comment and drops the rest of the key.
+// Reporting them here beats a confusing parse error or a 401 from a mangled key.
+func checkAuthKeyQuoting(data []byte) error {
+	for i, line := range strings.Split(string(data), "\n") {
+		match := authKeyLinePattern.FindStringSubmatch(line)
+		if match == nil || match[1] == "" {
+			continue
+		}
+		value := match[1]
+
+		var problem string
+		switch {
+		case strings.ContainsRune(`"'`, rune(value[0])):
+			continue
+		case strings.ContainsRune(yamlIndicators, rune(value[0])):
+			problem = fmt.Sprintf("it starts with '%c'", value[0])
+		case strings.Contains(value, ": "):
+			problem = "it contains ': '"
+		case strings.Contains(value, " #") || strings.Contains(value, "\t#"):
+			problem = "it contains ' #', which starts a comment"
+		default:
+			continue
+		}
+		return fmt.Errorf(errAuthKeyNeedsQuotes, i+1, problem)
+	}
+	return nil
+}
+
+// unknownFieldPattern matches the yaml.v3 strict-decoding error for a key with no matching field
+var unknownFieldPattern = regexp.MustCompile(`^line (\d+): field (\S+) not found in type`)
+
+// unknownKeys returns the keys, with their line numbers, that strict decoding rejected as
+// unknown, or nil when err is not (only) about unknown keys
+func unknownKeys(err error) []string {
+	var typeErr *yaml.TypeError
+	if !errors.As(err, &typeErr) {
+		return nil
+	}
+
+	keys := make([]string, 0, len(typeErr.Errors))
+	for _, message := range typeErr.Errors {
+		match := unknownFieldPattern.FindStringSubmatch(message)
+		if match == nil {
+			return nil
+		}
+		keys = append(keys, fmt.Sprintf("'%s' (line %s)", match[2], match[1]))
+	}
+	return keys
+}
+
+// LoadConfigMerged loads each config file in order and overlays them, so values from
+// later paths win over earlier ones. Only non-empty fields override. Paths that do not
+// exist are skipped; if none exist, the missing-config error for the last path is returned.
+func LoadConfigMerged(paths ...string) (*Config, error) {
+	merged := &Config{}
+	found := false
+
+	for _, path := range paths {
+		cfg, err := LoadConfigFromPath(path)
+		if err != nil {
+			if _, statErr := os.Stat(path); os.IsNotExist(statErr) {
+				continue
+			}
+			return nil, err
+		}
+		merged.overlay(cfg)
+		found = true
+	}
+
+	if !found {
+		if len(paths) == 0 {
+			return nil, fmt.Errorf("no config files given")
+		}
+		return nil, buildMissingConfigError(paths[len(paths)-1])
+	}
+	return merged, nil
+}
+
+// overlay copies every non-empty field of other onto c
+func (c *Config) overlay(other *Config) {
+	if other.AuthKey != "" {
+		c.AuthKey = other.AuthKey
+	}
+	if other.OrgID != "" {
+		c.OrgID = other.OrgID
+	}
+	if other.UserEmail != "" {
+		c.UserEmail = other.UserEmail
+	}
+	if other.RestDirectoryURL != "" {
+		c.RestDirectoryURL = other.RestDirectoryURL
+	}
+	if other.WebBaseURL != "" {
+		c.WebBaseURL = other.WebBaseURL
+	}
+	if other.DueSoonHours != 0 {
+		c.DueSoonHours = other.DueSoonHours
+	}
+	if other.LargeFetchThreshold != 0 {
+		c.LargeFetchThreshold = other.LargeFetchThreshold
+	}
+	if other.TimeoutSeconds != nil {
+		c.TimeoutSeconds = other.TimeoutSeconds
+	}
+	if other.PageSize != 0 {
+		c.PageSize = other.PageSize
+	}
+	if other.RateLimitMaxWaitSeconds != 0 {
+		c.RateLimitMaxWaitSeconds = other.RateLimitMaxWaitSeconds
+	}
+	if other.WrapWidth != 0 {
+		c.WrapWidth = other.WrapWidth
+	}
+	if other.Theme != "" {
+		c.Theme = other.Theme
+	}
+	if other.DefaultView != "" {
+		c.DefaultView = other.DefaultView
+	}
+	if len(other.HiddenBins) > 0 {
+		c.HiddenBins = other.HiddenBins
+	}
+	for element, color := range other.Colors {
+		if c.Colors == nil {
+			c.Colors = map[string]string{}
+		}
+		c.Colors[element] = color
+	}
+	for name, profile := range other.Profiles {
+		if c.Profiles == nil {
+			c.Profiles = map[string]Profile{}
+		}
+		c.Profiles[name] = profile
+	}
+	if other.DefaultProfile != "" {
+		c.DefaultProfile = other.DefaultProfile
+	}
+}
+
+// applyProfile replaces the top-level credentials with those of the named profile, or of
+// default_profile when name is empty. Fields the profile leaves out are cleared rather than
+// inherited, since top-level credentials may belong to another org. Without either, the flat
+// schema is used unchanged.
+func (c *Config) applyProfile(name string) error {
+	if name == "" {
+		name = c.DefaultProfile
+	}
+	if name == "" {
+		return nil
+	}
+
+	profile, ok := c.Profiles[name]
+	if !ok {
+		return fmt.Errorf(errProfileNotFound, name, c.profileNames())
+	}
+
+	c.AuthKey = profile.AuthKey
+	c.OrgID = profile.OrgID
+	c.UserEmail = profile.UserEmail
+	c.ActiveProfile = name
+	return nil
+}
+
+// profileNames lists the configured profile names, sorted, for error messages
+func (c *Config) profileNames() string {
+	if len(c.Profiles) == 0 {
+		return "none"
+	}
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// FindRepoConfig walks up from startDir looking for a repo-level .fb.yaml.
+// It returns the path of the first one found, or false if none exists up to the filesystem root.
+func FindRepoConfig(startDir string) (string, bool) {
+	dir := startDir
+	for {
+		candidate := filepath.Join(dir, repoConfigFileName)
+		if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+			return candidate, true
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+// repoConfigKeys are the only keys a repo-level .fb.yaml may set. Anything else, and above all
+// credentials or profiles, could let a cloned repository redirect or impersonate the user.
+var repoConfigKeys = map[string]bool{"org_id": true, "rest_directory_url": true}
+
+// CheckRepoConfig verifies that a repo-level .fb.yaml only sets the team keys, org_id and
+// rest_directory_url, and that rest_directory_url uses https. The auth key is sent to the
+// REST directory, so an untrusted repository must not be able to point it elsewhere in the clear.
+func CheckRepoConfig(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var fields map[string]any
+	if err := yaml.Unmarshal(data, &fields); err != nil {
+		return EnhanceYAMLError(err)
+	}
+	var disallowed []string
+	for key := range fields {
+		if !repoConfigKeys[key] {
+			disallowed = append(disallowed, key)
+		}
+	}
+	if len(disallowed) > 0 {
+		sort.Strings(disallowed)
+		return fmt.Errorf(errRepoConfigKeys, path, strings.Join(disallowed, ", "))
+	}
+
+	if directoryURL, ok := fields["rest_directory_url"].(string); ok && directoryURL != "" {
+		if parsed, err := url.Parse(directoryURL); err != nil || parsed.Scheme != "https" {
+			return fmt.Errorf(errRepoConfigInsecureURL, path, directoryURL)
+		}
+	}
+	return nil
+}
+
 // buildMissingConfigError creates a helpful error message for missing config (Story 5.2)
 func buildMissingConfigError(configPath string) error {
 	return fmt.Errorf("config file not found at %s\n\n%s",
@@ -107,20 +483,47 @@ user_email: you@example.com
 You can check your YAML syntax at: https://www.yamllint.com/`, err)
 }
 
-// Validate checks that all required configuration fields are present
+// Validate checks that all required configuration fields are present. When a profile
+// was applied, missing or malformed credentials are reported against that profile.
 func (c *Config) Validate() error {
-	if err := c.validateAuthKey(); err != nil {
+	if err := c.validateCredentials(); err != nil {
+		if c.ActiveProfile != "" {
+			return fmt.Errorf("profile '%s': %w", c.ActiveProfile, err)
+		}
 		return err
 	}
-	if err := c.validateOrgID(); err != nil {
+	if err := c.validateDueSoonHours(); err != nil {
+		return err
+	}
+	if err := c.validateLargeFetchThreshold(); err != nil {
 		return err
 	}
-	if err := c.validateUserEmail(); err != nil {
+	if err := c.validateWrapWidth(); err != nil {
+		return err
+	}
+	if err := c.validateDefaultView(); err != nil {
 		return err
 	}
 	return nil
 }
 
+// validateCredentials checks the auth_key, org_id, and user_email fields. Surrounding
+// whitespace is trimmed first, so a whitespace-only value counts as missing rather than
+// building a broken API URL.
+func (c *Config) validateCredentials() error {
+	c.AuthKey = strings.TrimSpace(c.AuthKey)
+	c.OrgID = strings.TrimSpace(c.OrgID)
+	c.UserEmail = strings.TrimSpace(c.UserEmail)
+
+	if err := c.validateAuthKey(); err != nil {
+		return err
+	}
+	if err := c.validateOrgID(); err != nil {
+		return err
+	}
+	return c.validateUserEmail()
+}
+
 // validateAuthKey checks if the auth_key field is present
 func (c *Config) validateAuthKey() error {
 	if c.AuthKey == "" {
@@ -137,35 +540,155 @@ func (c *Config) validateOrgID() error {
 	return nil
 }
 
-// validateUserEmail checks if the user_email field is present
+// validateUserEmail checks if the user_email field is present and shaped like an email address
 func (c *Config) validateUserEmail() error {
 	if c.UserEmail == "" {
 		return fmt.Errorf(errUserEmailRequired)
 	}
+	if !isPlausibleEmail(c.UserEmail) {
+		return fmt.Errorf(errUserEmailMalformed, c.UserEmail)
+	}
+	return nil
+}
+
+// isPlausibleEmail does a lenient shape check: a single @ with a non-empty local part and a
+// domain containing a dot that is neither leading nor trailing, and no whitespace anywhere.
+// Unusual but valid addresses such as a+b@sub.example.co.uk pass.
+func isPlausibleEmail(email string) bool {
+	if strings.ContainsAny(email, " \t\r\n") {
+		return false
+	}
+	local, domain, found := strings.Cut(email, "@")
+	if !found || local == "" || strings.Contains(domain, "@") {
+		return false
+	}
+	dot := strings.LastIndex(domain, ".")
+	return dot > 0 && dot < len(domain)-1 && !strings.HasPrefix(domain, ".")
+}
+
+// validateWrapWidth checks that the optional wrap_width field is a usable number of columns
+func (c *Config) validateWrapWidth() error {
+	if c.WrapWidth != 0 && (c.WrapWidth < MinWrapWidth || c.WrapWidth > MaxWrapWidth) {
+		return fmt.Errorf(errWrapWidthRange, MinWrapWidth, MaxWrapWidth, c.WrapWidth)
+	}
 	return nil
 }
 
-// LoadConfig reads the configuration from ~/.fb/config.yaml
+// validateDueSoonHours checks that the optional due_soon_hours field is not negative
+func (c *Config) validateDueSoonHours() error {
+	if c.DueSoonHours < 0 {
+		return fmt.Errorf(errDueSoonNegative)
+	}
+	return nil
+}
+
+// validateLargeFetchThreshold checks that the optional large_fetch_threshold field is not negative
+func (c *Config) validateLargeFetchThreshold() error {
+	if c.LargeFetchThreshold < 0 {
+		return fmt.Errorf(errLargeFetchNegative)
+	}
+	return nil
+}
+
+// validateDefaultView checks that the optional default_view field names a known view
+func (c *Config) validateDefaultView() error {
+	switch c.DefaultView {
+	case "", ViewMinimal, ViewTable, ViewVerbose, ViewJSON:
+		return nil
+	}
+	return fmt.Errorf(errDefaultViewUnknown, c.DefaultView)
+}
+
+// DueSoonWindow returns the configured due-soon window, or zero if none is configured
+func (c *Config) DueSoonWindow() time.Duration {
+	return time.Duration(c.DueSoonHours) * time.Hour
+}
+
+// explicitConfigPath is the file set with SetConfigPath; empty uses the default locations
+var explicitConfigPath string
+
+// SetConfigPath makes LoadConfig read only the file at path, as with fb --config.
+// An empty path restores the default locations.
+func SetConfigPath(path string) {
+	explicitConfigPath = path
+}
+
+// selectedProfile is the profile set with SetProfile; empty uses default_profile, if any
+var selectedProfile string
+
+// SetProfile makes LoadConfig use the named profile's credentials, as with fb --profile.
+// An empty name restores default_profile.
+func SetProfile(name string) {
+	selectedProfile = name
+}
+
+// LoadConfig reads the configuration from ~/.fb/config.yaml, merged over a repo-level
+// .fb.yaml found in the current directory or any of its parents. FB_AUTH_KEY, FB_ORG_ID,
+// and FB_USER_EMAIL override both files; when set, no config file is needed.
+// A path given with SetConfigPath is loaded instead, see LoadConfigAt.
 func LoadConfig() (*Config, error) {
-	// Story 5.1: Create config directory if it doesn't exist
-	home, err := os.UserHomeDir()
+	return LoadConfigAt(explicitConfigPath)
+}
+
+// LoadConfigAt reads and validates the config file at path, skipping the ~/.fb and
+// .fb.yaml lookup. Environment variables still override it. An empty path behaves
+// like LoadConfig without an explicit path.
+func LoadConfigAt(path string) (*Config, error) {
+	if path == "" {
+		return loadDefaultConfig()
+	}
+
+	cfg, err := LoadConfigFromPath(path)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get home directory: %w", err)
+		return nil, err
+	}
+	if err := cfg.applyProfile(selectedProfile); err != nil {
+		return nil, err
 	}
+	cfg.overlay(configFromEnv())
 
-	if err := EnsureConfigDirectory(home); err != nil {
+	if err := cfg.Validate(); err != nil {
 		return nil, err
 	}
+	return cfg, nil
+}
 
-	configPath, err := GetConfigPath()
+// loadDefaultConfig loads ~/.fb/config.yaml over any repo-level .fb.yaml, then the environment
+func loadDefaultConfig() (*Config, error) {
+	// Story 5.1: Create config directory if it doesn't exist
+	cfgDir, err := ConfigDir()
 	if err != nil {
 		return nil, err
 	}
+	if err := ensureConfigDirectoryAt(cfgDir); err != nil {
+		return nil, err
+	}
+	configPath := filepath.Join(cfgDir, configFileName)
+
+	// A repo-level .fb.yaml supplies shared team settings; the user-level file wins
+	paths := []string{configPath}
+	if cwd, err := os.Getwd(); err == nil {
+		if repoPath, ok := FindRepoConfig(cwd); ok {
+			if err := CheckRepoConfig(repoPath); err != nil {
+				return nil, err
+			}
+			paths = []string{repoPath, configPath}
+		}
+	}
 
-	cfg, err := LoadConfigFromPath(configPath)
+	// Environment variables override every file, and let CI run without a config file at all
+	env := configFromEnv()
+	cfg, err := LoadConfigMerged(paths...)
 	if err != nil {
+		if !env.hasAnyRequiredField() || anyPathExists(paths) {
+			return nil, err
+		}
+		cfg = &Config{}
+	}
+	if err := cfg.applyProfile(selectedProfile); err != nil {
 		return nil, err
 	}
+	cfg.overlay(env)
 
 	// Validate required fields (Story 1.3)
 	if err := cfg.Validate(); err != nil {
@@ -175,9 +698,99 @@ func LoadConfig() (*Config, error) {
 	return cfg, nil
 }
 
-// SaveConfig writes the configuration to ~/.fb/config.yaml
+// configFromEnv reads the config fields set through FB_AUTH_KEY, FB_ORG_ID, and FB_USER_EMAIL
+func configFromEnv() *Config {
+	return &Config{
+		AuthKey:   os.Getenv(envAuthKey),
+		OrgID:     os.Getenv(envOrgID),
+		UserEmail: os.Getenv(envUserEmail),
+	}
+}
+
+// hasAnyRequiredField reports whether any of auth_key, org_id, or user_email is set
+func (c *Config) hasAnyRequiredField() bool {
+	return c.AuthKey != "" || c.OrgID != "" || c.UserEmail != ""
+}
+
+// anyPathExists reports whether a file exists at any of the paths
+func anyPathExists(paths []string) bool {
+	for _, path := range paths {
+		if _, err := os.Stat(path); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// EditableKeys are the config keys that fb config set and fb config get accept
+var EditableKeys = []string{"auth_key", "org_id", "user_email"}
+
+// UserConfigPath returns the config file that fb config set and get edit: the path given with
+// SetConfigPath, or ~/.fb/config.yaml
+func UserConfigPath() (string, error) {
+	if explicitConfigPath != "" {
+		return explicitConfigPath, nil
+	}
+	return GetConfigPath()
+}
+
+// LoadUserConfig reads the UserConfigPath file for editing: without the repo-level .fb.yaml,
+// the environment, a profile, or validation, so a half-finished config can be filled in.
+// A missing file gives an empty config.
+func LoadUserConfig() (*Config, error) {
+	configPath, err := UserConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(configPath); os.IsNotExist(err) {
+		return &Config{}, nil
+	}
+	return LoadConfigFromPath(configPath)
+}
+
+// Get returns the value of one of the EditableKeys
+func (c *Config) Get(key string) (string, error) {
+	field, err := c.editableField(key)
+	if err != nil {
+		return "", err
+	}
+	return *field, nil
+}
+
+// Set updates one of the EditableKeys, trimming surrounding whitespace. Empty values and
+// malformed emails are rejected so the saved config stays loadable.
+func (c *Config) Set(key, value string) error {
+	field, err := c.editableField(key)
+	if err != nil {
+		return err
+	}
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return fmt.Errorf(errEmptyValue, key)
+	}
+	if key == "user_email" && !isPlausibleEmail(value) {
+		return fmt.Errorf(errUserEmailMalformed, value)
+	}
+	*field = value
+	return nil
+}
+
+// editableField returns the field behind one of the EditableKeys
+func (c *Config) editableField(key string) (*string, error) {
+	switch key {
+	case "auth_key":
+		return &c.AuthKey, nil
+	case "org_id":
+		return &c.OrgID, nil
+	case "user_email":
+		return &c.UserEmail, nil
+	}
+	return nil, fmt.Errorf(errKeyNotEditable, key, strings.Join(EditableKeys, ", "))
+}
+
+// SaveConfig writes the configuration to the UserConfigPath file
 func SaveConfig(cfg *Config) error {
-	configPath, err := GetConfigPath()
+	configPath, err := UserConfigPath()
 	if err != nil {
 		return err
 	}
@@ -205,10 +818,21 @@ func ensureConfigDirectory(configPath string) error {
 
 // EnsureConfigDirectory creates the ~/.fb directory if it doesn't exist (Story 5.1)
 // It ensures the directory has user-only permissions (0700) for security.
-// If the directory already exists, it is not modified.
+// If the directory already exists, it is not modified. An empty homeDir uses
+// FB_CONFIG_DIR instead.
 func EnsureConfigDirectory(homeDir string) error {
 	cfgDir := filepath.Join(homeDir, configDir)
+	if homeDir == "" {
+		var err error
+		if cfgDir, err = fallbackConfigDir(); err != nil {
+			return err
+		}
+	}
+	return ensureConfigDirectoryAt(cfgDir)
+}
 
+// ensureConfigDirectoryAt creates cfgDir with user-only permissions unless it already exists
+func ensureConfigDirectoryAt(cfgDir string) error {
 	if err := validateConfigDirectoryPath(cfgDir); err != nil {
 		return err
 	}
@@ -253,6 +877,27 @@ func createConfigDirectoryIfNeeded(cfgDir string) error {
 	return nil
 }
 
+// maskedAuthKey stands in for the auth key when the config is shown to anyone else
+const maskedAuthKey = "********"
+
+// MaskedYAML returns the config as YAML with the auth key masked, for sharing in support requests
+func (c *Config) MaskedYAML() ([]byte, error) {
+	masked := *c
+	if masked.AuthKey != "" {
+		masked.AuthKey = maskedAuthKey
+	}
+	if len(c.Profiles) > 0 {
+		masked.Profiles = make(map[string]Profile, len(c.Profiles))
+		for name, profile := range c.Profiles {
+			if profile.AuthKey != "" {
+				profile.AuthKey = maskedAuthKey
+			}
+			masked.Profiles[name] = profile
+		}
+	}
+	return marshalConfig(&masked)
+}
+
 // marshalConfig converts the config struct to YAML bytes
 func marshalConfig(cfg *Config) ([]byte, error) {
 	data, err := yaml.Marshal(cfg)
@@ -267,5 +912,9 @@ func writeConfigFile(configPath string, data []byte) error {
 	if err := os.WriteFile(configPath, data, configFilePerm); err != nil {
 		return fmt.Errorf("failed to write config file: %w", err)
 	}
+	// WriteFile keeps the mode of an existing file, which may be readable by others
+	if err := os.Chmod(configPath, configFilePerm); err != nil {
+		return fmt.Errorf("failed to restrict config file permissions: %w", err)
+	}
 	return nil
 }
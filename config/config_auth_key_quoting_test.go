@@ -0,0 +1,108 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeAuthKeyConfig writes a minimal config whose auth_key line is authKeyLine
+func writeAuthKeyConfig(t *testing.T, authKeyLine string) string {
+	t.Helper()
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	content := authKeyLine + "\norg_id: org\nuser_email: test@example.com\n"
+	if err := os.WriteFile(configPath, []byte(content), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	return configPath
+}
+
+// TestAuthKeyQuoting tests loading auth keys written with and without quotes
+//
+// Acceptance Criteria:
+// - Quoted and unquoted keys with plain characters load to the same value
+// - An unquoted key containing a colon without a following space loads unchanged
+// - An unquoted key YAML would misread is rejected with a hint to quote it and its line number
+// - An unquoted key containing ' #' is rejected rather than silently cut at the comment
+func TestAuthKeyQuoting(t *testing.T) {
+	t.Run("Given quoted and unquoted keys When loading Then both give the same value", func(t *testing.T) {
+		for _, line := range []string{`auth_key: abc123`, `auth_key: "abc123"`, `auth_key: 'abc123'`} {
+			cfg, err := LoadConfigAt(writeAuthKeyConfig(t, line))
+			if err != nil {
+				t.Fatalf("Expected %q to load, got %v", line, err)
+			}
+			if cfg.AuthKey != "abc123" {
+				t.Errorf("Expected auth key 'abc123' from %q, got %q", line, cfg.AuthKey)
+			}
+		}
+	})
+
+	t.Run("Given an unquoted key with a colon When loading Then it is read unchanged", func(t *testing.T) {
+		cfg, err := LoadConfigAt(writeAuthKeyConfig(t, "auth_key: 123:abc"))
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if cfg.AuthKey != "123:abc" {
+			t.Errorf("Expected auth key '123:abc', got %q", cfg.AuthKey)
+		}
+	})
+
+	t.Run("Given a quoted key with YAML special characters When loading Then it is accepted", func(t *testing.T) {
+		cfg, err := LoadConfigAt(writeAuthKeyConfig(t, `auth_key: "&abc: def"`))
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if cfg.AuthKey != "&abc: def" {
+			t.Errorf("Expected auth key '&abc: def', got %q", cfg.AuthKey)
+		}
+	})
+
+	t.Run("Given unquoted keys YAML would misread When loading Then the error asks for quotes", func(t *testing.T) {
+		for _, line := range []string{"auth_key: abc: def", "auth_key: &abc", "auth_key: !abc", "auth_key: *abc", "auth_key: %abc", "auth_key: @abc", "auth_key: #abc"} {
+			_, err := LoadConfigAt(writeAuthKeyConfig(t, line))
+
+			if err == nil {
+				t.Errorf("Expected an error for %q", line)
+				continue
+			}
+			if !strings.Contains(err.Error(), "line 1 must be quoted") || !strings.Contains(err.Error(), `auth_key: "your-key"`) {
+				t.Errorf("Expected a quoting hint for %q, got %v", line, err)
+			}
+		}
+	})
+
+	t.Run("Given an unquoted key containing ' #' When loading Then it is rejected instead of truncated", func(t *testing.T) {
+		for _, line := range []string{"auth_key: abc #def", "auth_key: abc\t#def"} {
+			_, err := LoadConfigAt(writeAuthKeyConfig(t, line))
+
+			if err == nil || !strings.Contains(err.Error(), "starts a comment") {
+				t.Errorf("Expected a quoting hint for %q, got %v", line, err)
+			}
+		}
+
+		cfg, err := LoadConfigAt(writeAuthKeyConfig(t, `auth_key: "abc #def"`))
+		if err != nil {
+			t.Fatalf("Expected the quoted key to load, got %v", err)
+		}
+		if cfg.AuthKey != "abc #def" {
+			t.Errorf("Expected auth key 'abc #def', got %q", cfg.AuthKey)
+		}
+	})
+
+	t.Run("Given a misread key under a profile When loading Then the error names its line", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.yaml")
+		content := "auth_key: key\norg_id: org\nuser_email: test@example.com\nprofiles:\n  work:\n    auth_key: &abc\n"
+		if err := os.WriteFile(configPath, []byte(content), 0600); err != nil {
+			t.Fatalf("Failed to write config: %v", err)
+		}
+
+		_, err := LoadConfigAt(configPath)
+
+		if err == nil || !strings.Contains(err.Error(), "line 6 must be quoted") {
+			t.Errorf("Expected a quoting hint for line 6, got %v", err)
+		}
+	})
+}
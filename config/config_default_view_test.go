@@ -0,0 +1,39 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestValidateDefaultView tests validation of the default_view config key
+//
+// Acceptance Criteria:
+// - minimal, table, verbose, json, and an unset value are accepted
+// - An unknown value is a load-time error that names the value
+func TestValidateDefaultView(t *testing.T) {
+	t.Run("Given a known view When validating Then no error", func(t *testing.T) {
+		for _, view := range []string{"", ViewMinimal, ViewTable, ViewVerbose, ViewJSON} {
+			cfg := &Config{AuthKey: "key", OrgID: "org", UserEmail: "test@example.com", DefaultView: view}
+
+			if err := cfg.Validate(); err != nil {
+				t.Errorf("Expected no error for %q, got %v", view, err)
+			}
+		}
+	})
+
+	t.Run("Given an unknown view in the config file When loading Then the error names it", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.yaml")
+		content := "auth_key: key\norg_id: org\nuser_email: test@example.com\ndefault_view: grid\n"
+		if err := os.WriteFile(configPath, []byte(content), 0600); err != nil {
+			t.Fatalf("Failed to write config: %v", err)
+		}
+
+		_, err := LoadConfigAt(configPath)
+
+		if err == nil || !strings.Contains(err.Error(), "default_view 'grid'") {
+			t.Errorf("Expected default_view error naming 'grid', got %v", err)
+		}
+	})
+}
@@ -0,0 +1,77 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestConfigDirFallback tests locating the config without a home directory
+//
+// Acceptance Criteria:
+// - With HOME set, the config lives in ~/.fb and FB_CONFIG_DIR is not needed
+// - Without HOME, FB_CONFIG_DIR holds config.yaml and is created if missing
+// - Without either, the error says to set HOME or FB_CONFIG_DIR
+func TestConfigDirFallback(t *testing.T) {
+	clearEnv := func(t *testing.T) {
+		t.Helper()
+		t.Setenv(envAuthKey, "")
+		t.Setenv(envOrgID, "")
+		t.Setenv(envUserEmail, "")
+		t.Chdir(t.TempDir())
+	}
+
+	t.Run("Given HOME When resolving the config path Then it is under ~/.fb", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		t.Setenv(envConfigDir, t.TempDir())
+
+		path, err := GetConfigPath()
+
+		if err != nil || path != filepath.Join(home, ".fb", "config.yaml") {
+			t.Errorf("Expected ~/.fb/config.yaml, got %q (%v)", path, err)
+		}
+	})
+
+	t.Run("Given no HOME and FB_CONFIG_DIR When loading Then the config is read from it", func(t *testing.T) {
+		clearEnv(t)
+		dir := filepath.Join(t.TempDir(), "fb")
+		t.Setenv("HOME", "")
+		t.Setenv(envConfigDir, dir)
+		if err := EnsureConfigDirectory(""); err != nil {
+			t.Fatalf("Expected FB_CONFIG_DIR to be created, got %v", err)
+		}
+		content := "auth_key: key\norg_id: org\nuser_email: test@example.com\n"
+		if err := os.WriteFile(filepath.Join(dir, "config.yaml"), []byte(content), 0600); err != nil {
+			t.Fatalf("Failed to write config: %v", err)
+		}
+
+		cfg, err := LoadConfig()
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if cfg.OrgID != "org" {
+			t.Errorf("Expected org_id from FB_CONFIG_DIR, got %q", cfg.OrgID)
+		}
+		if info, err := os.Stat(dir); err != nil || info.Mode().Perm() != 0700 {
+			t.Errorf("Expected FB_CONFIG_DIR created with 0700, got %v (%v)", info, err)
+		}
+	})
+
+	t.Run("Given neither HOME nor FB_CONFIG_DIR When loading Then the error names both", func(t *testing.T) {
+		clearEnv(t)
+		t.Setenv("HOME", "")
+		t.Setenv(envConfigDir, "")
+
+		_, err := LoadConfig()
+
+		if err == nil || !strings.Contains(err.Error(), "HOME") || !strings.Contains(err.Error(), "FB_CONFIG_DIR") {
+			t.Errorf("Expected an error naming HOME and FB_CONFIG_DIR, got %v", err)
+		}
+		if err := EnsureConfigDirectory(""); err == nil {
+			t.Error("Expected EnsureConfigDirectory to fail without FB_CONFIG_DIR")
+		}
+	})
+}
@@ -0,0 +1,123 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestConfigSetGet tests editing single keys for fb config set and get
+//
+// Acceptance Criteria:
+// - auth_key, org_id, and user_email can be set and read back, trimmed
+// - Unknown keys, empty values, and malformed emails are rejected
+// - LoadUserConfig starts empty without a config file and reads an incomplete one unvalidated
+// - A path set with SetConfigPath is the file loaded and saved
+// - Saving over an existing world-readable file restricts it to the user
+func TestConfigSetGet(t *testing.T) {
+	t.Run("Given an editable key When setting it Then the value reads back trimmed", func(t *testing.T) {
+		cfg := &Config{}
+		values := map[string]string{"auth_key": " 123:abc ", "org_id": "org", "user_email": "me@example.com"}
+
+		for key, value := range values {
+			if err := cfg.Set(key, value); err != nil {
+				t.Fatalf("Expected no error setting %s, got %v", key, err)
+			}
+			if got, _ := cfg.Get(key); got != strings.TrimSpace(value) {
+				t.Errorf("Expected %s %q, got %q", key, strings.TrimSpace(value), got)
+			}
+		}
+		if cfg.AuthKey != "123:abc" {
+			t.Errorf("Expected AuthKey to be set, got %q", cfg.AuthKey)
+		}
+	})
+
+	t.Run("Given invalid input When setting Then an error is returned", func(t *testing.T) {
+		cfg := &Config{}
+		cases := map[string][2]string{
+			"unknown config key 'theme'": {"theme", "dark"},
+			"auth_key cannot be empty":   {"auth_key", "  "},
+			"looks malformed":            {"user_email", "not-an-email"},
+		}
+		for want, input := range cases {
+			err := cfg.Set(input[0], input[1])
+			if err == nil || !strings.Contains(err.Error(), want) {
+				t.Errorf("Expected error containing %q, got %v", want, err)
+			}
+		}
+		if _, err := cfg.Get("theme"); err == nil {
+			t.Error("Expected an error getting an unknown key")
+		}
+	})
+
+	t.Run("Given no config file When loading for editing Then an empty config is returned", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		cfg, err := LoadUserConfig()
+
+		if err != nil || cfg.AuthKey != "" {
+			t.Errorf("Expected an empty config, got %+v (%v)", cfg, err)
+		}
+	})
+
+	t.Run("Given an incomplete config file When loading for editing Then it is read without validation", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		os.MkdirAll(filepath.Join(home, ".fb"), 0700)
+		if err := os.WriteFile(filepath.Join(home, ".fb", "config.yaml"), []byte("org_id: org\n"), 0600); err != nil {
+			t.Fatalf("Failed to write config: %v", err)
+		}
+
+		cfg, err := LoadUserConfig()
+
+		if err != nil || cfg.OrgID != "org" {
+			t.Errorf("Expected org_id 'org', got %+v (%v)", cfg, err)
+		}
+	})
+	t.Run("Given an explicit config path When saving Then that file is written and ~/.fb is untouched", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		altPath := filepath.Join(t.TempDir(), "alt.yaml")
+		SetConfigPath(altPath)
+		t.Cleanup(func() { SetConfigPath("") })
+
+		cfg, err := LoadUserConfig()
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		cfg.Set("org_id", "alt-org")
+		if err := SaveConfig(cfg); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if data, err := os.ReadFile(altPath); err != nil || !strings.Contains(string(data), "alt-org") {
+			t.Errorf("Expected org_id in %s, got %q (%v)", altPath, data, err)
+		}
+		if _, err := os.Stat(filepath.Join(home, ".fb", "config.yaml")); !os.IsNotExist(err) {
+			t.Errorf("Expected ~/.fb/config.yaml not to be created, got %v", err)
+		}
+	})
+
+	t.Run("Given a world-readable config file When saving Then it is restricted to the user", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		configPath := filepath.Join(home, ".fb", "config.yaml")
+		os.MkdirAll(filepath.Dir(configPath), 0700)
+		if err := os.WriteFile(configPath, []byte("org_id: org\n"), 0644); err != nil {
+			t.Fatalf("Failed to write config: %v", err)
+		}
+
+		if err := SaveConfig(&Config{OrgID: "org"}); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		info, err := os.Stat(configPath)
+		if err != nil {
+			t.Fatalf("Failed to stat config: %v", err)
+		}
+		if perm := info.Mode().Perm(); perm != 0600 {
+			t.Errorf("Expected permissions 0600, got %o", perm)
+		}
+	})
+}
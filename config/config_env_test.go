@@ -0,0 +1,70 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestLoadConfigEnvOverrides tests FB_AUTH_KEY, FB_ORG_ID, and FB_USER_EMAIL
+//
+// Acceptance Criteria:
+// - Environment variables override the config file fields
+// - With all three set, a missing config file is not an error
+// - An env-only setup missing one variable fails validation for that field
+func TestLoadConfigEnvOverrides(t *testing.T) {
+	setHome := func(t *testing.T) string {
+		t.Helper()
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		t.Setenv(envAuthKey, "")
+		t.Setenv(envOrgID, "")
+		t.Setenv(envUserEmail, "")
+		return home
+	}
+
+	t.Run("Given a config file and env variables When loading Then env wins over the file", func(t *testing.T) {
+		home := setHome(t)
+		os.MkdirAll(filepath.Join(home, ".fb"), 0700)
+		content := "auth_key: file-key\norg_id: file-org\nuser_email: file@example.com\n"
+		if err := os.WriteFile(filepath.Join(home, ".fb", "config.yaml"), []byte(content), 0600); err != nil {
+			t.Fatalf("Failed to write config: %v", err)
+		}
+		t.Setenv(envAuthKey, "env-key")
+
+		cfg, err := LoadConfig()
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if cfg.AuthKey != "env-key" || cfg.OrgID != "file-org" || cfg.UserEmail != "file@example.com" {
+			t.Errorf("Expected env auth key over file values, got %+v", cfg)
+		}
+	})
+
+	t.Run("Given all three env variables and no config file When loading Then it succeeds", func(t *testing.T) {
+		setHome(t)
+		t.Setenv(envAuthKey, "env-key")
+		t.Setenv(envOrgID, "env-org")
+		t.Setenv(envUserEmail, "ci@example.com")
+
+		cfg, err := LoadConfig()
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if cfg.AuthKey != "env-key" || cfg.OrgID != "env-org" || cfg.UserEmail != "ci@example.com" {
+			t.Errorf("Expected env values, got %+v", cfg)
+		}
+	})
+
+	t.Run("Given env variables missing one field and no config file When loading Then validation names it", func(t *testing.T) {
+		setHome(t)
+		t.Setenv(envAuthKey, "env-key")
+		t.Setenv(envUserEmail, "ci@example.com")
+
+		_, err := LoadConfig()
+		if err == nil || !strings.Contains(err.Error(), "org_id is required") {
+			t.Errorf("Expected missing org_id error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,187 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestLoadConfigMerged tests overlaying a repo-level config with a user-level config
+//
+// User Story:
+// As a team, we want shared settings (org_id, rest_directory_url) in a repo-level .fb.yaml
+// while each person keeps their auth_key in ~/.fb/config.yaml.
+//
+// Acceptance Criteria:
+// - Files are overlaid in order, later files winning
+// - Empty fields in later files do not erase earlier values
+// - Missing files are skipped; if all are missing, the friendly missing-config error is returned
+func TestLoadConfigMerged(t *testing.T) {
+	writeFile := func(t *testing.T, path, content string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatalf("Failed to write %s: %v", path, err)
+		}
+	}
+
+	t.Run("Given repo and user configs When merging Then user-level values win", func(t *testing.T) {
+		tempDir := t.TempDir()
+		repoPath := filepath.Join(tempDir, ".fb.yaml")
+		userPath := filepath.Join(tempDir, "config.yaml")
+		writeFile(t, repoPath, "org_id: team-org\nrest_directory_url: https://directory.example.com\nuser_email: shared@example.com\n")
+		writeFile(t, userPath, "auth_key: personal-key\nuser_email: me@example.com\n")
+
+		cfg, err := LoadConfigMerged(repoPath, userPath)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if cfg.AuthKey != "personal-key" {
+			t.Errorf("Expected auth_key from user config, got %q", cfg.AuthKey)
+		}
+		if cfg.OrgID != "team-org" {
+			t.Errorf("Expected org_id from repo config, got %q", cfg.OrgID)
+		}
+		if cfg.RestDirectoryURL != "https://directory.example.com" {
+			t.Errorf("Expected rest_directory_url from repo config, got %q", cfg.RestDirectoryURL)
+		}
+		if cfg.UserEmail != "me@example.com" {
+			t.Errorf("Expected user-level user_email to win, got %q", cfg.UserEmail)
+		}
+	})
+
+	t.Run("Given a missing repo config When merging Then the user config is used alone", func(t *testing.T) {
+		tempDir := t.TempDir()
+		userPath := filepath.Join(tempDir, "config.yaml")
+		writeFile(t, userPath, "auth_key: key\norg_id: org\nuser_email: me@example.com\n")
+
+		cfg, err := LoadConfigMerged(filepath.Join(tempDir, "missing.yaml"), userPath)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if cfg.OrgID != "org" {
+			t.Errorf("Expected org_id from user config, got %q", cfg.OrgID)
+		}
+	})
+
+	t.Run("Given no existing files When merging Then return the missing-config error", func(t *testing.T) {
+		tempDir := t.TempDir()
+		userPath := filepath.Join(tempDir, "config.yaml")
+
+		_, err := LoadConfigMerged(filepath.Join(tempDir, ".fb.yaml"), userPath)
+
+		if err == nil {
+			t.Fatal("Expected error when no config files exist")
+		}
+		if !strings.Contains(err.Error(), userPath) {
+			t.Errorf("Expected error to point at the user config path, got: %v", err)
+		}
+	})
+}
+
+// TestFindRepoConfig tests discovery of a repo-level .fb.yaml by walking up from a directory
+func TestFindRepoConfig(t *testing.T) {
+	t.Run("Given .fb.yaml in a parent directory When searching from a subdirectory Then it is found", func(t *testing.T) {
+		root := t.TempDir()
+		repoPath := filepath.Join(root, ".fb.yaml")
+		if err := os.WriteFile(repoPath, []byte("org_id: team-org\n"), 0600); err != nil {
+			t.Fatalf("Failed to write repo config: %v", err)
+		}
+		nested := filepath.Join(root, "src", "pkg")
+		if err := os.MkdirAll(nested, 0755); err != nil {
+			t.Fatalf("Failed to create nested dir: %v", err)
+		}
+
+		found, ok := FindRepoConfig(nested)
+
+		if !ok {
+			t.Fatal("Expected repo config to be found")
+		}
+		if found != repoPath {
+			t.Errorf("Expected %s, got %s", repoPath, found)
+		}
+	})
+
+	t.Run("Given no .fb.yaml anywhere When searching Then nothing is found", func(t *testing.T) {
+		nested := filepath.Join(t.TempDir(), "a", "b")
+		if err := os.MkdirAll(nested, 0755); err != nil {
+			t.Fatalf("Failed to create nested dir: %v", err)
+		}
+
+		if found, ok := FindRepoConfig(nested); ok {
+			t.Errorf("Expected no repo config, found %s", found)
+		}
+	})
+}
+
+// TestRepoConfigRestrictions tests what a repo-level .fb.yaml may set
+//
+// Acceptance Criteria:
+// - org_id and an https rest_directory_url are accepted and merged under the user config
+// - Credentials, profiles, and any other key are rejected with the offending key named
+// - A rest_directory_url that is not https is rejected
+func TestRepoConfigRestrictions(t *testing.T) {
+	setup := func(t *testing.T, repoContent string) {
+		t.Helper()
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		t.Setenv(envAuthKey, "")
+		t.Setenv(envOrgID, "")
+		t.Setenv(envUserEmail, "")
+		os.MkdirAll(filepath.Join(home, ".fb"), 0700)
+		user := "auth_key: personal-key\nuser_email: me@example.com\n"
+		if err := os.WriteFile(filepath.Join(home, ".fb", "config.yaml"), []byte(user), 0600); err != nil {
+			t.Fatalf("Failed to write user config: %v", err)
+		}
+		repo := t.TempDir()
+		if err := os.WriteFile(filepath.Join(repo, ".fb.yaml"), []byte(repoContent), 0600); err != nil {
+			t.Fatalf("Failed to write repo config: %v", err)
+		}
+		t.Chdir(repo)
+	}
+
+	t.Run("Given team keys in the repo config When loading Then they are merged", func(t *testing.T) {
+		setup(t, "org_id: team-org\nrest_directory_url: https://directory.example.com\n")
+
+		cfg, err := LoadConfig()
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if cfg.OrgID != "team-org" || cfg.RestDirectoryURL != "https://directory.example.com" || cfg.AuthKey != "personal-key" {
+			t.Errorf("Expected merged team and personal values, got %+v", cfg)
+		}
+	})
+
+	disallowed := map[string]string{
+		"auth_key":        "org_id: team-org\nauth_key: stolen\n",
+		"user_email":      "org_id: team-org\nuser_email: other@example.com\n",
+		"profiles":        "profiles:\n  evil:\n    auth_key: x\n",
+		"default_profile": "default_profile: evil\n",
+		"web_base_url":    "web_base_url: https://evil.example.com\n",
+		"hidden_bins":     "hidden_bins: [Doing]\n",
+	}
+	for key, content := range disallowed {
+		t.Run("Given "+key+" in the repo config When loading Then it is rejected", func(t *testing.T) {
+			setup(t, content)
+
+			_, err := LoadConfig()
+
+			if err == nil || !strings.Contains(err.Error(), key) || !strings.Contains(err.Error(), "may only set") {
+				t.Errorf("Expected an error naming %s, got %v", key, err)
+			}
+		})
+	}
+
+	t.Run("Given an http rest_directory_url in the repo config When loading Then it is rejected", func(t *testing.T) {
+		setup(t, "org_id: team-org\nrest_directory_url: http://directory.example.com\n")
+
+		_, err := LoadConfig()
+
+		if err == nil || !strings.Contains(err.Error(), "must use https") {
+			t.Errorf("Expected an https error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestPageSizeConfig tests reading the page_size key
+//
+// Acceptance Criteria:
+// - Without page_size, PageSize is zero so the client default applies
+// - page_size from a repo-level .fb.yaml survives the merge with the user config
+func TestPageSizeConfig(t *testing.T) {
+	write := func(t *testing.T, dir, content string) string {
+		t.Helper()
+		path := filepath.Join(dir, "config.yaml")
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatalf("Failed to write config: %v", err)
+		}
+		return path
+	}
+	const credentials = "auth_key: key\norg_id: org\nuser_email: me@example.com\n"
+
+	t.Run("Given no page_size When loading Then PageSize is zero", func(t *testing.T) {
+		cfg, err := LoadConfigFromPath(write(t, t.TempDir(), credentials))
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if cfg.PageSize != 0 {
+			t.Errorf("Expected 0, got %d", cfg.PageSize)
+		}
+	})
+
+	t.Run("Given page_size in the repo config When merging Then it is kept", func(t *testing.T) {
+		repoPath := write(t, t.TempDir(), "page_size: 100\n")
+		userPath := write(t, t.TempDir(), credentials)
+
+		cfg, err := LoadConfigMerged(repoPath, userPath)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if cfg.PageSize != 100 {
+			t.Errorf("Expected 100, got %d", cfg.PageSize)
+		}
+	})
+}
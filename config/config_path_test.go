@@ -0,0 +1,79 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestLoadConfigAt tests loading an explicit config file, as with fb --config
+//
+// Acceptance Criteria:
+// - The given file is loaded instead of ~/.fb/config.yaml, which is not created
+// - The loaded file is still validated
+// - A nonexistent path gives the missing-config error naming that path
+func TestLoadConfigAt(t *testing.T) {
+	setup := func(t *testing.T) (home, dir string) {
+		t.Helper()
+		home = t.TempDir()
+		t.Setenv("HOME", home)
+		t.Setenv(envAuthKey, "")
+		t.Setenv(envOrgID, "")
+		t.Setenv(envUserEmail, "")
+		return home, t.TempDir()
+	}
+
+	t.Run("Given an explicit path When loading Then that file is used", func(t *testing.T) {
+		home, dir := setup(t)
+		path := filepath.Join(dir, "alt.yaml")
+		os.WriteFile(path, []byte("auth_key: alt-key\norg_id: alt-org\nuser_email: alt@example.com\n"), 0600)
+
+		cfg, err := LoadConfigAt(path)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if cfg.OrgID != "alt-org" {
+			t.Errorf("Expected alt-org, got %s", cfg.OrgID)
+		}
+		if _, err := os.Stat(filepath.Join(home, ".fb")); !os.IsNotExist(err) {
+			t.Errorf("Expected ~/.fb not to be created, stat returned %v", err)
+		}
+	})
+
+	t.Run("Given an explicit file missing org_id When loading Then validation fails", func(t *testing.T) {
+		_, dir := setup(t)
+		path := filepath.Join(dir, "alt.yaml")
+		os.WriteFile(path, []byte("auth_key: alt-key\nuser_email: alt@example.com\n"), 0600)
+
+		if _, err := LoadConfigAt(path); err == nil || err.Error() != errOrgIDRequired {
+			t.Errorf("Expected %q, got %v", errOrgIDRequired, err)
+		}
+	})
+
+	t.Run("Given a nonexistent explicit path When loading Then the missing-config error names it", func(t *testing.T) {
+		_, dir := setup(t)
+		path := filepath.Join(dir, "nope.yaml")
+
+		_, err := LoadConfigAt(path)
+
+		if err == nil || !strings.Contains(err.Error(), path) {
+			t.Errorf("Expected the missing-config error for %s, got %v", path, err)
+		}
+	})
+
+	t.Run("Given SetConfigPath When calling LoadConfig Then the explicit file is used", func(t *testing.T) {
+		_, dir := setup(t)
+		path := filepath.Join(dir, "alt.yaml")
+		os.WriteFile(path, []byte("auth_key: alt-key\norg_id: set-org\nuser_email: alt@example.com\n"), 0600)
+		SetConfigPath(path)
+		defer SetConfigPath("")
+
+		cfg, err := LoadConfig()
+
+		if err != nil || cfg.OrgID != "set-org" {
+			t.Errorf("Expected set-org, got %+v (%v)", cfg, err)
+		}
+	})
+}
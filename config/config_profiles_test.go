@@ -0,0 +1,152 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestConfigProfiles tests selecting named credential profiles from one config file
+//
+// Acceptance Criteria:
+// - default_profile supplies the credentials when no profile is selected
+// - SetProfile (--profile) selects another profile, overriding default_profile
+// - Configs without profiles keep using the flat auth_key/org_id/user_email fields
+// - An unknown profile name errors and lists the available profiles
+// - Validation errors for the selected profile name that profile
+// - A profile does not inherit top-level credentials it leaves out
+// - Environment variables still override the selected profile
+// - MaskedYAML masks the auth key of every profile
+func TestConfigProfiles(t *testing.T) {
+	const profilesYAML = `default_profile: work
+profiles:
+  work:
+    auth_key: work-key
+    org_id: work-org
+    user_email: me@work.example.com
+  client:
+    auth_key: client-key
+    org_id: client-org
+    user_email: me@client.example.com
+  broken:
+    auth_key: broken-key
+    org_id: broken-org
+`
+
+	setup := func(t *testing.T, content string) string {
+		t.Helper()
+		t.Setenv("HOME", t.TempDir())
+		t.Setenv(envAuthKey, "")
+		t.Setenv(envOrgID, "")
+		t.Setenv(envUserEmail, "")
+		t.Cleanup(func() { SetProfile("") })
+
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatalf("Failed to write config: %v", err)
+		}
+		return path
+	}
+
+	t.Run("Given a default profile When loading without --profile Then its credentials are used", func(t *testing.T) {
+		path := setup(t, profilesYAML)
+
+		cfg, err := LoadConfigAt(path)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if cfg.OrgID != "work-org" || cfg.AuthKey != "work-key" || cfg.ActiveProfile != "work" {
+			t.Errorf("Expected the work profile, got %+v", cfg)
+		}
+	})
+
+	t.Run("Given --profile client When loading Then the client profile wins over the default", func(t *testing.T) {
+		path := setup(t, profilesYAML)
+		SetProfile("client")
+
+		cfg, err := LoadConfigAt(path)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if cfg.OrgID != "client-org" || cfg.UserEmail != "me@client.example.com" {
+			t.Errorf("Expected the client profile, got %+v", cfg)
+		}
+	})
+
+	t.Run("Given a flat config When loading Then the top-level fields are used", func(t *testing.T) {
+		path := setup(t, "auth_key: flat-key\norg_id: flat-org\nuser_email: me@example.com\n")
+
+		cfg, err := LoadConfigAt(path)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if cfg.OrgID != "flat-org" || cfg.ActiveProfile != "" {
+			t.Errorf("Expected the flat config, got %+v", cfg)
+		}
+	})
+
+	t.Run("Given an unknown profile When loading Then the error lists the available profiles", func(t *testing.T) {
+		path := setup(t, profilesYAML)
+		SetProfile("personal")
+
+		_, err := LoadConfigAt(path)
+		if err == nil || !strings.Contains(err.Error(), "profile 'personal' not found") || !strings.Contains(err.Error(), "broken, client, work") {
+			t.Errorf("Expected profile-not-found error listing profiles, got %v", err)
+		}
+	})
+
+	t.Run("Given an incomplete profile When loading Then validation names the profile", func(t *testing.T) {
+		path := setup(t, profilesYAML)
+		SetProfile("broken")
+
+		_, err := LoadConfigAt(path)
+		if err == nil || err.Error() != "profile 'broken': "+errUserEmailRequired {
+			t.Errorf("Expected missing user_email error for profile broken, got %v", err)
+		}
+	})
+
+	t.Run("Given top-level credentials and an incomplete profile When loading Then the missing field is not inherited", func(t *testing.T) {
+		path := setup(t, "auth_key: flat-key\norg_id: flat-org\nuser_email: me@example.com\n"+profilesYAML)
+		SetProfile("broken")
+
+		_, err := LoadConfigAt(path)
+		if err == nil || err.Error() != "profile 'broken': "+errUserEmailRequired {
+			t.Errorf("Expected missing user_email error for profile broken, got %v", err)
+		}
+	})
+
+	t.Run("Given FB_ORG_ID When loading a profile Then the environment wins", func(t *testing.T) {
+		path := setup(t, profilesYAML)
+		t.Setenv(envOrgID, "env-org")
+
+		cfg, err := LoadConfigAt(path)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if cfg.OrgID != "env-org" || cfg.AuthKey != "work-key" {
+			t.Errorf("Expected env org over the work profile, got %+v", cfg)
+		}
+	})
+
+	t.Run("Given profiles When masking the config Then no profile auth key appears", func(t *testing.T) {
+		path := setup(t, profilesYAML)
+
+		cfg, err := LoadConfigAt(path)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		data, err := cfg.MaskedYAML()
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		for _, key := range []string{"work-key", "client-key", "broken-key"} {
+			if strings.Contains(string(data), key) {
+				t.Errorf("Auth key %s leaked into masked config:\n%s", key, data)
+			}
+		}
+		if cfg.Profiles["client"].AuthKey != "client-key" {
+			t.Error("Expected masking to leave the loaded config unchanged")
+		}
+	})
+}
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestTimeoutSecondsConfig tests reading the timeout_seconds key
+//
+// Acceptance Criteria:
+// - Without timeout_seconds, TimeoutSeconds is nil so the client default applies
+// - An explicit timeout_seconds, including 0, is kept
+func TestTimeoutSecondsConfig(t *testing.T) {
+	load := func(t *testing.T, extra string) *Config {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		content := "auth_key: key\norg_id: org\nuser_email: me@example.com\n" + extra
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatalf("Failed to write config: %v", err)
+		}
+		cfg, err := LoadConfigFromPath(path)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		return cfg
+	}
+
+	t.Run("Given no timeout_seconds When loading Then the timeout is unset", func(t *testing.T) {
+		if cfg := load(t, ""); cfg.TimeoutSeconds != nil {
+			t.Errorf("Expected nil, got %d", *cfg.TimeoutSeconds)
+		}
+	})
+
+	t.Run("Given timeout_seconds: 5 When loading Then it is kept", func(t *testing.T) {
+		cfg := load(t, "timeout_seconds: 5\n")
+		if cfg.TimeoutSeconds == nil || *cfg.TimeoutSeconds != 5 {
+			t.Errorf("Expected 5, got %v", cfg.TimeoutSeconds)
+		}
+	})
+
+	t.Run("Given timeout_seconds: 0 When loading Then the explicit zero is kept", func(t *testing.T) {
+		cfg := load(t, "timeout_seconds: 0\n")
+		if cfg.TimeoutSeconds == nil || *cfg.TimeoutSeconds != 0 {
+			t.Errorf("Expected an explicit 0, got %v", cfg.TimeoutSeconds)
+		}
+	})
+}
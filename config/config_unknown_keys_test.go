@@ -0,0 +1,59 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestLoadConfigUnknownKeys tests strict decoding of the config file
+//
+// Acceptance Criteria:
+// - A misspelled key is reported by name and line instead of as a missing required field
+// - Genuine YAML syntax errors still get the enhanced guidance
+// - An empty file still loads as an empty config
+func TestLoadConfigUnknownKeys(t *testing.T) {
+	write := func(t *testing.T, content string) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatalf("Failed to write config: %v", err)
+		}
+		return path
+	}
+
+	t.Run("Given a misspelled auth_keys When loading Then the error names the unexpected key", func(t *testing.T) {
+		path := write(t, "auth_keys: secret\norg_id: org\nuser_email: me@example.com\n")
+
+		_, err := LoadConfigFromPath(path)
+
+		if err == nil {
+			t.Fatal("Expected an error for the unknown key")
+		}
+		if !strings.Contains(err.Error(), "'auth_keys' (line 1)") {
+			t.Errorf("Expected the error to name auth_keys, got: %v", err)
+		}
+		if strings.Contains(err.Error(), "YAML syntax error") {
+			t.Errorf("Expected no syntax guidance for a misspelled key, got: %v", err)
+		}
+	})
+
+	t.Run("Given a syntax error When loading Then the enhanced guidance is kept", func(t *testing.T) {
+		path := write(t, "auth_key: \"unterminated\norg_id: org\n")
+
+		_, err := LoadConfigFromPath(path)
+
+		if err == nil || !strings.Contains(err.Error(), "YAML syntax error") {
+			t.Errorf("Expected the enhanced syntax error, got: %v", err)
+		}
+	})
+
+	t.Run("Given an empty file When loading Then an empty config is returned", func(t *testing.T) {
+		cfg, err := LoadConfigFromPath(write(t, ""))
+
+		if err != nil || cfg == nil {
+			t.Errorf("Expected an empty config, got %v (%v)", cfg, err)
+		}
+	})
+}
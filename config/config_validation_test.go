@@ -1,6 +1,8 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -151,3 +153,76 @@ func TestStory1_3_EmptyConfig(t *testing.T) {
 		t.Error("Error message should not be empty")
 	}
 }
+
+// TestValidateUserEmailShape tests that a malformed user_email is rejected with a clear message
+func TestValidateUserEmailShape(t *testing.T) {
+	valid := []string{"test@example.com", "a+b@sub.example.co.uk", "first.last@example.io"}
+	for _, email := range valid {
+		t.Run("Given "+email+" When validating Then it is accepted", func(t *testing.T) {
+			cfg := &Config{AuthKey: "test-auth-key", OrgID: "test-org-id", UserEmail: email}
+
+			if err := cfg.Validate(); err != nil {
+				t.Errorf("Expected %s to be valid, got: %v", email, err)
+			}
+		})
+	}
+
+	malformed := []string{"test@example", "teste xample.com", "@example.com", "test@", "test@.com", "test@example.", "a@b@example.com"}
+	for _, email := range malformed {
+		t.Run("Given "+email+" When validating Then it is reported as malformed", func(t *testing.T) {
+			cfg := &Config{AuthKey: "test-auth-key", OrgID: "test-org-id", UserEmail: email}
+
+			err := cfg.Validate()
+			if err == nil {
+				t.Fatalf("Expected an error for %q, got nil", email)
+			}
+			if !strings.Contains(err.Error(), "looks malformed") || !strings.Contains(err.Error(), email) {
+				t.Errorf("Expected a malformed error naming %q, got: %v", email, err)
+			}
+		})
+	}
+}
+
+// TestValidateWhitespaceCredentials tests that credentials are trimmed before validation
+//
+// Acceptance Criteria:
+// - A whitespace-only auth_key, org_id, or user_email gives the usual "required" error
+// - Surrounding whitespace is trimmed from valid values
+func TestValidateWhitespaceCredentials(t *testing.T) {
+	t.Run("Given a whitespace-only org_id in the config file When loading Then it is reported as required", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.yaml")
+		content := "auth_key: key\norg_id: \"   \"\nuser_email: test@example.com\n"
+		if err := os.WriteFile(configPath, []byte(content), 0600); err != nil {
+			t.Fatalf("Failed to write config: %v", err)
+		}
+
+		_, err := LoadConfigAt(configPath)
+
+		if err == nil || !strings.Contains(err.Error(), errOrgIDRequired) {
+			t.Errorf("Expected %q, got %v", errOrgIDRequired, err)
+		}
+	})
+
+	t.Run("Given whitespace-only auth_key and user_email When validating Then each is reported as required", func(t *testing.T) {
+		cases := map[string]*Config{
+			errAuthKeyRequired:   {AuthKey: " \t", OrgID: "org", UserEmail: "test@example.com"},
+			errUserEmailRequired: {AuthKey: "key", OrgID: "org", UserEmail: "  "},
+		}
+		for want, cfg := range cases {
+			if err := cfg.Validate(); err == nil || err.Error() != want {
+				t.Errorf("Expected %q, got %v", want, err)
+			}
+		}
+	})
+
+	t.Run("Given values with surrounding spaces When validating Then they are trimmed", func(t *testing.T) {
+		cfg := &Config{AuthKey: " key ", OrgID: " org ", UserEmail: " test@example.com "}
+
+		if err := cfg.Validate(); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if cfg.AuthKey != "key" || cfg.OrgID != "org" || cfg.UserEmail != "test@example.com" {
+			t.Errorf("Expected trimmed values, got %q, %q, %q", cfg.AuthKey, cfg.OrgID, cfg.UserEmail)
+		}
+	})
+}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestValidateWrapWidth tests validation of the wrap_width config key
+//
+// Acceptance Criteria:
+// - An unset wrap_width and values within the bounds are accepted
+// - Values below MinWrapWidth or above MaxWrapWidth are load-time errors naming the value
+func TestValidateWrapWidth(t *testing.T) {
+	t.Run("Given wrap_width within bounds When validating Then no error", func(t *testing.T) {
+		for _, width := range []int{0, MinWrapWidth, 120, MaxWrapWidth} {
+			cfg := &Config{AuthKey: "key", OrgID: "org", UserEmail: "test@example.com", WrapWidth: width}
+
+			if err := cfg.Validate(); err != nil {
+				t.Errorf("Expected no error for %d, got %v", width, err)
+			}
+		}
+	})
+
+	t.Run("Given wrap_width out of bounds When validating Then an error names it", func(t *testing.T) {
+		for _, width := range []int{-1, MinWrapWidth - 1, MaxWrapWidth + 1} {
+			cfg := &Config{AuthKey: "key", OrgID: "org", UserEmail: "test@example.com", WrapWidth: width}
+
+			err := cfg.Validate()
+			if err == nil || !strings.Contains(err.Error(), "wrap_width") {
+				t.Errorf("Expected a wrap_width error for %d, got %v", width, err)
+			}
+		}
+	})
+
+	t.Run("Given wrap_width in the config file When loading Then it is read", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.yaml")
+		content := "auth_key: key\norg_id: org\nuser_email: test@example.com\nwrap_width: 120\n"
+		if err := os.WriteFile(configPath, []byte(content), 0600); err != nil {
+			t.Fatalf("Failed to write config: %v", err)
+		}
+
+		cfg, err := LoadConfigAt(configPath)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if cfg.WrapWidth != 120 {
+			t.Errorf("Expected wrap_width 120, got %d", cfg.WrapWidth)
+		}
+	})
+}
@@ -0,0 +1,34 @@
+// Package fb exposes Flow Boards ticket fetching to other Go programs without the CLI.
+//
+// Load a configuration with config.LoadConfig (or build and Validate a config.Config
+// yourself) and pass it to FetchAssignedTickets:
+//
+//	cfg, err := config.LoadConfig()
+//	if err != nil {
+//		return err
+//	}
+//	tickets, err := fb.FetchAssignedTickets(cfg)
+//
+// Use FetchAssignedTicketsContext to cancel the fetch or bound it with a deadline.
+package fb
+
+import (
+	"context"
+
+	"github.com/Germanicus1/fb/config"
+	"github.com/Germanicus1/fb/internal/service"
+	"github.com/Germanicus1/fb/models"
+)
+
+// FetchAssignedTickets returns the tickets assigned to cfg.UserEmail. It discovers the org's
+// API endpoint, looks up the user, and searches their tickets, reusing the same caches
+// under ~/.fb as the fb command.
+func FetchAssignedTickets(cfg *config.Config) ([]models.Ticket, error) {
+	return FetchAssignedTicketsContext(context.Background(), cfg)
+}
+
+// FetchAssignedTicketsContext is FetchAssignedTickets sending every request with ctx, so
+// cancelling ctx aborts the fetch.
+func FetchAssignedTicketsContext(ctx context.Context, cfg *config.Config) ([]models.Ticket, error) {
+	return service.FetchAssignedTickets(ctx, cfg, service.Options{})
+}
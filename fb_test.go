@@ -0,0 +1,85 @@
+package fb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Germanicus1/fb/config"
+)
+
+// TestFetchAssignedTickets tests the programmatic entrypoint used by other Go programs
+//
+// Acceptance Criteria:
+// - Discovers the REST prefix, looks up the configured user, and searches their tickets
+// - Returns the tickets without any CLI output
+// - Surfaces API failures as errors
+// - A cancelled context aborts the fetch
+func TestFetchAssignedTickets(t *testing.T) {
+	newServer := func(t *testing.T, searchStatus int) (*httptest.Server, *string) {
+		var searchedUser string
+		var server *httptest.Server
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/users/me@example.com":
+				fmt.Fprint(w, `{"_id": "user-1", "email": "me@example.com"}`)
+			case "/ticket-search":
+				searchedUser = r.URL.Query().Get("users")
+				w.WriteHeader(searchStatus)
+				fmt.Fprint(w, `[{"_id": "T1", "name": "One"}, {"_id": "T2", "name": "Two"}]`)
+			default:
+				fmt.Fprintf(w, `{"restUrlPrefix": "%s"}`, server.URL)
+			}
+		}))
+		t.Cleanup(server.Close)
+		return server, &searchedUser
+	}
+
+	t.Run("Given a valid config When fetching Then the user's tickets are returned", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		server, searchedUser := newServer(t, http.StatusOK)
+		cfg := &config.Config{AuthKey: "test-key", OrgID: "org-123", UserEmail: "me@example.com", RestDirectoryURL: server.URL}
+
+		tickets, err := FetchAssignedTickets(cfg)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(tickets) != 2 || tickets[0].ID != "T1" || tickets[1].ID != "T2" {
+			t.Errorf("Expected T1 and T2, got %+v", tickets)
+		}
+		if *searchedUser != "user-1" {
+			t.Errorf("Expected search for user-1, got %q", *searchedUser)
+		}
+	})
+
+	t.Run("Given a failing search When fetching Then an error is returned", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		server, _ := newServer(t, http.StatusUnauthorized)
+		cfg := &config.Config{AuthKey: "test-key", OrgID: "org-123", UserEmail: "me@example.com", RestDirectoryURL: server.URL}
+
+		if _, err := FetchAssignedTickets(cfg); err == nil {
+			t.Error("Expected an error, got nil")
+		}
+	})
+
+	t.Run("Given a cancelled context When fetching Then the fetch is aborted", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		server, searchedUser := newServer(t, http.StatusOK)
+		cfg := &config.Config{AuthKey: "test-key", OrgID: "org-123", UserEmail: "me@example.com", RestDirectoryURL: server.URL}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := FetchAssignedTicketsContext(ctx, cfg)
+
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+		if *searchedUser != "" {
+			t.Errorf("Expected no search to be made, got one for %q", *searchedUser)
+		}
+	})
+}
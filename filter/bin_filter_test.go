@@ -15,6 +15,7 @@ import (
 // - Tickets from all boards are included if they match the bin filter
 // - Empty result set is handled gracefully
 // - Filter works with bin names containing spaces and special characters
+// - Leading and trailing whitespace in the filter value is ignored; ticket bin names are not trimmed
 func TestFilterTicketsByBinName(t *testing.T) {
 	t.Run("Given tickets When filtering by exact bin name Then return matching tickets", func(t *testing.T) {
 		// Arrange
@@ -99,6 +100,24 @@ func TestFilterTicketsByBinName(t *testing.T) {
 			t.Errorf("Expected 0 tickets, got %d", len(filtered))
 		}
 	})
+
+	t.Run("Given a space-padded filter When filtering Then it matches by name and by ID", func(t *testing.T) {
+		tickets := []models.Ticket{
+			{ID: "1", Name: "Ticket 1", BinName: "Doing", BinID: "bin1"},
+			{ID: "2", Name: "Ticket 2", BinName: "Done", BinID: "bin2"},
+			{ID: "3", Name: "Ticket 3", BinName: " Doing ", BinID: "bin3"},
+		}
+
+		byName := FilterByBinName(tickets, " Doing ")
+		if len(byName) != 1 || byName[0].ID != "1" {
+			t.Errorf("Expected only ticket 1 by trimmed name, got %v", byName)
+		}
+
+		byID := FilterByBinName(tickets, "\tbin2 ")
+		if len(byID) != 1 || byID[0].ID != "2" {
+			t.Errorf("Expected ticket 2 by trimmed ID, got %v", byID)
+		}
+	})
 }
 
 // TestFilterTicketsByBinID tests Story 8: Filter Tickets by Bin ID
@@ -0,0 +1,58 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestFilterTicketsByBoardName tests filtering tickets by board name or board ID
+//
+// Acceptance Criteria:
+// - Filtering matches board name case-insensitively
+// - When a value matches a BoardID exactly, filtering uses BoardID
+// - ID-based filtering is case-sensitive and exact match
+// - Board names containing spaces and special characters match correctly
+// - Tickets without board data never match a non-empty filter
+// - Empty result sets are handled gracefully
+func TestFilterTicketsByBoardName(t *testing.T) {
+	tickets := []models.Ticket{
+		{ID: "1", Name: "Ticket 1", BoardName: "Product Roadmap", BoardID: "board-123"},
+		{ID: "2", Name: "Ticket 2", BoardName: "Sprint", BoardID: "board-456"},
+		{ID: "3", Name: "Ticket 3", BoardName: "Product Roadmap", BoardID: "board-123"},
+		{ID: "4", Name: "Ticket 4", BoardName: "K+Dev.Ops", BoardID: "board-789"},
+		{ID: "5", Name: "Ticket 5"},
+		{ID: "6", Name: "Ticket 6", BoardName: "Test", BoardID: "BOARD-ABC"},
+	}
+
+	tests := []struct {
+		name     string
+		tickets  []models.Ticket
+		filter   string
+		expected []string
+	}{
+		{"exact board name", tickets, "Product Roadmap", []string{"1", "3"}},
+		{"mixed case board name", tickets, "pRoDuCt rOaDmAp", []string{"1", "3"}},
+		{"board name with special characters", tickets, "k+dev.ops", []string{"4"}},
+		{"board ID", tickets, "board-456", []string{"2"}},
+		{"board ID with different case", tickets, "board-abc", []string{}},
+		{"non-existent board", tickets, "Marketing", []string{}},
+		{"empty ticket list", []models.Ticket{}, "Sprint", []string{}},
+		{"tickets without board data", []models.Ticket{{ID: "5"}, {ID: "7", BoardID: "board-1"}}, "Sprint", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run("Given tickets When filtering by "+tt.name+" Then return matching tickets", func(t *testing.T) {
+			filtered := FilterByBoardName(tt.tickets, tt.filter)
+
+			if len(filtered) != len(tt.expected) {
+				t.Fatalf("Expected %d tickets, got %d: %v", len(tt.expected), len(filtered), filtered)
+			}
+			for i, id := range tt.expected {
+				if filtered[i].ID != id {
+					t.Errorf("Expected ticket %s at position %d, got %s", id, i, filtered[i].ID)
+				}
+			}
+		})
+	}
+}
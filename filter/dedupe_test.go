@@ -0,0 +1,61 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestDedupeByID tests removing repeated tickets from search results
+//
+// Acceptance Criteria:
+// - Only the first occurrence of each ticket ID is kept
+// - The order of first occurrences is preserved
+// - A list without duplicates is returned unchanged, and empty input returns an empty list
+func TestDedupeByID(t *testing.T) {
+	t.Run("Given deliberate duplicate IDs When deduplicating Then keep the first occurrence in order", func(t *testing.T) {
+		// Arrange
+		tickets := []models.Ticket{
+			{ID: "1", Name: "First", BinName: "To Do"},
+			{ID: "2", Name: "Second", BinName: "Doing"},
+			{ID: "1", Name: "First again", BinName: "Done"},
+			{ID: "3", Name: "Third"},
+			{ID: "2", Name: "Second again"},
+		}
+
+		// Act
+		deduped := DedupeByID(tickets)
+
+		// Assert
+		if len(deduped) != 3 {
+			t.Fatalf("Expected 3 tickets, got %d", len(deduped))
+		}
+		for i, expected := range []string{"1", "2", "3"} {
+			if deduped[i].ID != expected {
+				t.Errorf("Expected ticket %s at position %d, got %s", expected, i, deduped[i].ID)
+			}
+		}
+		if deduped[0].BinName != "To Do" || deduped[1].Name != "Second" {
+			t.Errorf("Expected first occurrences to be kept, got %v", deduped)
+		}
+	})
+
+	t.Run("Given unique IDs When deduplicating Then the list is unchanged", func(t *testing.T) {
+		// Arrange
+		tickets := []models.Ticket{{ID: "1"}, {ID: "2"}}
+
+		// Act
+		deduped := DedupeByID(tickets)
+
+		// Assert
+		if len(deduped) != 2 || deduped[0].ID != "1" || deduped[1].ID != "2" {
+			t.Errorf("Expected tickets 1 and 2, got %v", deduped)
+		}
+	})
+
+	t.Run("Given no tickets When deduplicating Then return empty list", func(t *testing.T) {
+		if deduped := DedupeByID(nil); deduped == nil || len(deduped) != 0 {
+			t.Errorf("Expected empty non-nil list, got %v", deduped)
+		}
+	})
+}
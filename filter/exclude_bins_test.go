@@ -0,0 +1,56 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestFilterExcludeBins tests dropping tickets in hidden bins
+//
+// Acceptance Criteria:
+// - Tickets in any listed bin are dropped, matching names case-insensitively or IDs exactly
+// - Tickets without a bin and in other bins are kept, in their original order
+// - No bin names returns all tickets unchanged
+func TestFilterExcludeBins(t *testing.T) {
+	tickets := []models.Ticket{
+		{ID: "1", BinName: "In Progress", BinID: "bin-progress"},
+		{ID: "2", BinName: "Done", BinID: "bin-done"},
+		{ID: "3"},
+		{ID: "4", BinName: "archived", BinID: "bin-archived"},
+		{ID: "5", BinName: "To Do", BinID: "bin-todo"},
+	}
+
+	t.Run("Given hidden bin names in another case When excluding Then tickets in those bins are dropped", func(t *testing.T) {
+		filtered := FilterExcludeBins(tickets, []string{"done", "Archived"})
+
+		expected := []string{"1", "3", "5"}
+		if len(filtered) != len(expected) {
+			t.Fatalf("Expected %d tickets, got %d: %+v", len(expected), len(filtered), filtered)
+		}
+		for i, id := range expected {
+			if filtered[i].ID != id {
+				t.Errorf("Expected ticket %s at position %d, got %s", id, i, filtered[i].ID)
+			}
+		}
+	})
+
+	t.Run("Given a hidden bin ID When excluding Then tickets in that bin are dropped", func(t *testing.T) {
+		filtered := FilterExcludeBins(tickets, []string{"bin-todo"})
+
+		if len(filtered) != 4 {
+			t.Errorf("Expected 4 tickets, got %d", len(filtered))
+		}
+		for _, ticket := range filtered {
+			if ticket.ID == "5" {
+				t.Error("Expected the To Do ticket to be dropped")
+			}
+		}
+	})
+
+	t.Run("Given no hidden bins When excluding Then all tickets are returned", func(t *testing.T) {
+		if filtered := FilterExcludeBins(tickets, nil); len(filtered) != len(tickets) {
+			t.Errorf("Expected all %d tickets, got %d", len(tickets), len(filtered))
+		}
+	})
+}
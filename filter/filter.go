@@ -1,15 +1,20 @@
 package filter
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Germanicus1/fb/models"
 )
 
 // FilterByBinName filters tickets by bin name or bin ID
-// First tries exact match on BinID, then falls back to case-insensitive match on BinName
+// First tries exact match on BinID, then falls back to case-insensitive match on BinName.
+// Surrounding whitespace in binFilter, e.g. from shell quoting, is ignored.
 func FilterByBinName(tickets []models.Ticket, binFilter string) []models.Ticket {
 	result := []models.Ticket{}
+	binFilter = strings.TrimSpace(binFilter)
 	lowerBinFilter := strings.ToLower(binFilter)
 
 	for _, ticket := range tickets {
@@ -26,3 +31,178 @@ func FilterByBinName(tickets []models.Ticket, binFilter string) []models.Ticket
 
 	return result
 }
+
+// FilterByBinNames returns the tickets matching any of the given bin names or IDs, with the
+// same matching rules as FilterByBinName. Original order is kept and each ticket appears at
+// most once. An empty list of names returns all tickets unchanged.
+func FilterByBinNames(tickets []models.Ticket, names []string) []models.Ticket {
+	if len(names) == 0 {
+		return tickets
+	}
+
+	result := []models.Ticket{}
+	for _, ticket := range tickets {
+		for _, name := range names {
+			if ticket.BinID == name || strings.EqualFold(ticket.BinName, name) {
+				result = append(result, ticket)
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+// FilterByBoardName filters tickets by board name or board ID
+// First tries exact match on BoardID, then falls back to case-insensitive match on BoardName.
+// Tickets without board data never match a non-empty filter.
+func FilterByBoardName(tickets []models.Ticket, boardName string) []models.Ticket {
+	result := []models.Ticket{}
+	lowerBoardName := strings.ToLower(boardName)
+
+	for _, ticket := range tickets {
+		// Try exact match on board_id first
+		if ticket.BoardID != "" && ticket.BoardID == boardName {
+			result = append(result, ticket)
+			continue
+		}
+		// Fall back to case-insensitive match on board_name
+		if ticket.BoardName != "" && strings.ToLower(ticket.BoardName) == lowerBoardName {
+			result = append(result, ticket)
+		}
+	}
+
+	return result
+}
+
+// FilterBySearchTerm returns the tickets whose name or description contains term,
+// compared case-insensitively. An empty term returns all tickets unchanged.
+func FilterBySearchTerm(tickets []models.Ticket, term string) []models.Ticket {
+	if term == "" {
+		return tickets
+	}
+
+	result := []models.Ticket{}
+	lowerTerm := strings.ToLower(term)
+
+	for _, ticket := range tickets {
+		if strings.Contains(strings.ToLower(ticket.Name), lowerTerm) ||
+			strings.Contains(strings.ToLower(ticket.Description), lowerTerm) {
+			result = append(result, ticket)
+		}
+	}
+
+	return result
+}
+
+// DedupeByID drops tickets whose ID already appeared earlier in the list, keeping the
+// first occurrence and the original order. Search results can repeat a ticket in some orgs.
+func DedupeByID(tickets []models.Ticket) []models.Ticket {
+	result := []models.Ticket{}
+	seen := make(map[string]bool, len(tickets))
+
+	for _, ticket := range tickets {
+		if seen[ticket.ID] {
+			continue
+		}
+		seen[ticket.ID] = true
+		result = append(result, ticket)
+	}
+
+	return result
+}
+
+// FilterUpdatedSince returns the tickets updated at or after cutoff.
+// Tickets without an update time are excluded.
+func FilterUpdatedSince(tickets []models.Ticket, cutoff time.Time) []models.Ticket {
+	result := []models.Ticket{}
+
+	for _, ticket := range tickets {
+		if !ticket.UpdatedAt.IsZero() && !ticket.UpdatedAt.Before(cutoff) {
+			result = append(result, ticket)
+		}
+	}
+
+	return result
+}
+
+// sinceUnits maps the unit suffixes accepted by ParseSince to their length
+var sinceUnits = map[byte]time.Duration{'d': 24 * time.Hour, 'h': time.Hour}
+
+// ParseSince converts a --since value into a cutoff time: a relative age such as "24h" or
+// "7d" counted back from now, or an absolute YYYY-MM-DD date taken as the start of that day
+// in now's location
+func ParseSince(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if date, err := time.ParseInLocation("2006-01-02", value, now.Location()); err == nil {
+		return date, nil
+	}
+
+	invalid := fmt.Errorf("invalid --since value '%s': use an age like 24h or 7d, or a date like 2026-01-01", value)
+	if len(value) < 2 {
+		return time.Time{}, invalid
+	}
+	unit, ok := sinceUnits[value[len(value)-1]]
+	amount, err := strconv.Atoi(value[:len(value)-1])
+	if !ok || err != nil || amount < 0 {
+		return time.Time{}, invalid
+	}
+	return now.Add(-time.Duration(amount) * unit), nil
+}
+
+// FilterOverdue returns the tickets whose due date is on a day before today.
+// Tickets without a due date are never overdue and are excluded.
+func FilterOverdue(tickets []models.Ticket) []models.Ticket {
+	return FilterOverdueAt(tickets, time.Now())
+}
+
+// FilterOverdueAt returns the tickets whose due date is on a day before now's day
+func FilterOverdueAt(tickets []models.Ticket, now time.Time) []models.Ticket {
+	result := []models.Ticket{}
+
+	for _, ticket := range tickets {
+		if ticket.IsOverdueAt(now) {
+			result = append(result, ticket)
+		}
+	}
+
+	return result
+}
+
+// FilterExcludeBins drops the tickets in any of the given bins, matched by bin ID or
+// case-insensitively by bin name. An empty list of names returns all tickets unchanged.
+func FilterExcludeBins(tickets []models.Ticket, names []string) []models.Ticket {
+	if len(names) == 0 {
+		return tickets
+	}
+
+	result := []models.Ticket{}
+	for _, ticket := range tickets {
+		hidden := false
+		for _, name := range names {
+			if ticket.BinID == name || strings.EqualFold(ticket.BinName, name) {
+				hidden = true
+				break
+			}
+		}
+		if !hidden {
+			result = append(result, ticket)
+		}
+	}
+
+	return result
+}
+
+// FilterNoBin returns the tickets that have neither a bin ID nor a bin name,
+// e.g. newly created tickets or ones returned with partial data
+func FilterNoBin(tickets []models.Ticket) []models.Ticket {
+	result := []models.Ticket{}
+
+	for _, ticket := range tickets {
+		if ticket.BinID == "" && ticket.BinName == "" {
+			result = append(result, ticket)
+		}
+	}
+
+	return result
+}
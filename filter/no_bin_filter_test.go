@@ -0,0 +1,63 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestFilterNoBin tests selecting tickets that have no bin assigned
+//
+// Acceptance Criteria:
+// - Tickets with both BinID and BinName empty are returned
+// - Tickets with either a bin ID or a bin name are excluded
+// - Empty input and all-binned input return an empty list
+func TestFilterNoBin(t *testing.T) {
+	t.Run("Given tickets with and without bins When filtering for no bin Then return only orphaned tickets", func(t *testing.T) {
+		// Arrange
+		tickets := []models.Ticket{
+			{ID: "1", Name: "Ticket 1", BinName: "In Progress", BinID: "bin1"},
+			{ID: "2", Name: "Ticket 2"},
+			{ID: "3", Name: "Ticket 3", BinID: "bin3"},
+			{ID: "4", Name: "Ticket 4", BinName: "Done"},
+			{ID: "5", Name: "Ticket 5"},
+		}
+
+		// Act
+		filtered := FilterNoBin(tickets)
+
+		// Assert
+		if len(filtered) != 2 {
+			t.Fatalf("Expected 2 tickets, got %d", len(filtered))
+		}
+		if filtered[0].ID != "2" || filtered[1].ID != "5" {
+			t.Errorf("Expected tickets 2 and 5, got %v", filtered)
+		}
+	})
+
+	t.Run("Given tickets that all have bins When filtering for no bin Then return empty list", func(t *testing.T) {
+		// Arrange
+		tickets := []models.Ticket{
+			{ID: "1", Name: "Ticket 1", BinName: "In Progress", BinID: "bin1"},
+			{ID: "2", Name: "Ticket 2", BinID: "bin2"},
+		}
+
+		// Act
+		filtered := FilterNoBin(tickets)
+
+		// Assert
+		if len(filtered) != 0 {
+			t.Errorf("Expected 0 tickets, got %d", len(filtered))
+		}
+	})
+
+	t.Run("Given no tickets When filtering for no bin Then return empty list", func(t *testing.T) {
+		// Act
+		filtered := FilterNoBin([]models.Ticket{})
+
+		// Assert
+		if filtered == nil || len(filtered) != 0 {
+			t.Errorf("Expected empty non-nil list, got %v", filtered)
+		}
+	})
+}
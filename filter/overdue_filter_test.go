@@ -0,0 +1,65 @@
+package filter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestFilterOverdue tests selecting tickets whose due date has passed
+//
+// Acceptance Criteria:
+// - Tickets due on a day before today are returned, in their original order
+// - Tickets due today or in the future are excluded
+// - Tickets without a due date are excluded
+// - Input with nothing overdue returns an empty list
+func TestFilterOverdue(t *testing.T) {
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	t.Run("Given past, today, future, and missing due dates When filtering overdue Then return only past ones", func(t *testing.T) {
+		// Arrange
+		tickets := []models.Ticket{
+			{ID: "1", Name: "Last week", DueDate: now.AddDate(0, 0, -7)},
+			{ID: "2", Name: "Today", DueDate: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)},
+			{ID: "3", Name: "Tomorrow", DueDate: now.AddDate(0, 0, 1)},
+			{ID: "4", Name: "No due date"},
+			{ID: "5", Name: "Yesterday evening", DueDate: time.Date(2026, 3, 9, 23, 0, 0, 0, time.UTC)},
+		}
+
+		// Act
+		filtered := FilterOverdueAt(tickets, now)
+
+		// Assert
+		if len(filtered) != 2 {
+			t.Fatalf("Expected 2 tickets, got %d", len(filtered))
+		}
+		if filtered[0].ID != "1" || filtered[1].ID != "5" {
+			t.Errorf("Expected tickets 1 and 5, got %v", filtered)
+		}
+	})
+
+	t.Run("Given no overdue tickets When filtering overdue Then return empty list", func(t *testing.T) {
+		// Arrange
+		tickets := []models.Ticket{
+			{ID: "1", Name: "Future", DueDate: now.AddDate(0, 1, 0)},
+			{ID: "2", Name: "No due date"},
+		}
+
+		// Act
+		filtered := FilterOverdueAt(tickets, now)
+
+		// Assert
+		if filtered == nil || len(filtered) != 0 {
+			t.Errorf("Expected empty non-nil list, got %v", filtered)
+		}
+	})
+
+	t.Run("Given a ticket due long ago When filtering against the real clock Then it is overdue", func(t *testing.T) {
+		tickets := []models.Ticket{{ID: "1", DueDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)}, {ID: "2"}}
+
+		if filtered := FilterOverdue(tickets); len(filtered) != 1 || filtered[0].ID != "1" {
+			t.Errorf("Expected only ticket 1, got %v", filtered)
+		}
+	})
+}
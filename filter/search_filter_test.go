@@ -0,0 +1,51 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestFilterTicketsBySearchTerm tests text search across ticket name and description
+//
+// Acceptance Criteria:
+// - Tickets whose name or description contains the term are returned
+// - Matching is case-insensitive, including non-ASCII letters
+// - Tickets with empty descriptions are still matched on their name
+// - An empty term returns the whole list
+func TestFilterTicketsBySearchTerm(t *testing.T) {
+	tickets := []models.Ticket{
+		{ID: "1", Name: "Fix LOGIN bug"},
+		{ID: "2", Name: "Update docs", Description: "Mention the login page"},
+		{ID: "3", Name: "Refactor parser", Description: "No relation"},
+		{ID: "4", Name: "Übersetzung prüfen", Description: "German copy"},
+	}
+
+	tests := []struct {
+		name     string
+		term     string
+		expected []string
+	}{
+		{"a term in names and descriptions", "login", []string{"1", "2"}},
+		{"a mixed case term", "LoGiN", []string{"1", "2"}},
+		{"a non-ASCII term in different case", "ÜBERSETZUNG", []string{"4"}},
+		{"a term in a description only", "relation", []string{"3"}},
+		{"a term matching nothing", "payment", []string{}},
+		{"an empty term", "", []string{"1", "2", "3", "4"}},
+	}
+
+	for _, tt := range tests {
+		t.Run("Given tickets When searching for "+tt.name+" Then return matching tickets", func(t *testing.T) {
+			filtered := FilterBySearchTerm(tickets, tt.term)
+
+			if len(filtered) != len(tt.expected) {
+				t.Fatalf("Expected %d tickets, got %d: %v", len(tt.expected), len(filtered), filtered)
+			}
+			for i, id := range tt.expected {
+				if filtered[i].ID != id {
+					t.Errorf("Expected ticket %s at position %d, got %s", id, i, filtered[i].ID)
+				}
+			}
+		})
+	}
+}
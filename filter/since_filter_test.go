@@ -0,0 +1,79 @@
+package filter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestParseSince tests parsing --since values into a cutoff time
+//
+// Acceptance Criteria:
+// - "24h" and "3d" count back from now
+// - An ISO date is the start of that day in now's location
+// - Values without a known unit, with a negative amount, or empty are rejected
+func TestParseSince(t *testing.T) {
+	now := time.Date(2026, 3, 10, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Time
+	}{
+		{"Given 24h When parsing Then the cutoff is one day ago", "24h", time.Date(2026, 3, 9, 15, 30, 0, 0, time.UTC)},
+		{"Given 3d When parsing Then the cutoff is three days ago", "3d", time.Date(2026, 3, 7, 15, 30, 0, 0, time.UTC)},
+		{"Given an ISO date When parsing Then the cutoff is the start of that day", "2026-01-01", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cutoff, err := ParseSince(tt.value, now)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !cutoff.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, cutoff)
+			}
+		})
+	}
+
+	t.Run("Given invalid values When parsing Then an error is returned", func(t *testing.T) {
+		for _, value := range []string{"", "7", "d", "7w", "-3d", "7dh", "2026-13-01"} {
+			if _, err := ParseSince(value, now); err == nil {
+				t.Errorf("Expected an error for %q", value)
+			}
+		}
+	})
+}
+
+// TestFilterUpdatedSince tests selecting recently updated tickets
+//
+// Acceptance Criteria:
+// - Tickets updated at or after the cutoff are returned, in order
+// - Tickets updated before the cutoff are excluded
+// - Tickets with no update time are excluded
+func TestFilterUpdatedSince(t *testing.T) {
+	cutoff := time.Date(2026, 3, 3, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Given mixed update times When filtering Then return tickets updated since the cutoff", func(t *testing.T) {
+		// Arrange
+		tickets := []models.Ticket{
+			{ID: "1", UpdatedAt: cutoff.Add(time.Hour)},
+			{ID: "2", UpdatedAt: cutoff.Add(-time.Hour)},
+			{ID: "3"},
+			{ID: "4", UpdatedAt: cutoff},
+		}
+
+		// Act
+		filtered := FilterUpdatedSince(tickets, cutoff)
+
+		// Assert
+		if len(filtered) != 2 {
+			t.Fatalf("Expected 2 tickets, got %d", len(filtered))
+		}
+		if filtered[0].ID != "1" || filtered[1].ID != "4" {
+			t.Errorf("Expected tickets 1 and 4, got %v", filtered)
+		}
+	})
+}
@@ -0,0 +1,45 @@
+package filter
+
+import "strings"
+
+// ClosestName returns the name closest to target by case-insensitive Levenshtein distance,
+// for suggesting a correction to a typo. Only names within maxDistance edits qualify, and an
+// exact (case-insensitive) match means there is nothing to correct. Ties go to the earlier name.
+func ClosestName(target string, names []string, maxDistance int) (string, bool) {
+	target = strings.ToLower(strings.TrimSpace(target))
+	best, bestDistance := "", maxDistance+1
+	for _, name := range names {
+		distance := levenshtein(target, strings.ToLower(name))
+		if distance == 0 {
+			return "", false
+		}
+		if distance < bestDistance {
+			best, bestDistance = name, distance
+		}
+	}
+	return best, best != ""
+}
+
+// levenshtein returns the number of single-character insertions, deletions, and substitutions
+// needed to turn a into b
+func levenshtein(a, b string) int {
+	source, target := []rune(a), []rune(b)
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(source); i++ {
+		current[0] = i
+		for j := 1; j <= len(target); j++ {
+			cost := 1
+			if source[i-1] == target[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(target)]
+}
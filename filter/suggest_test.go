@@ -0,0 +1,52 @@
+package filter
+
+import "testing"
+
+// TestClosestName tests suggesting the nearest name for a mistyped value
+//
+// Acceptance Criteria:
+// - The name with the fewest edits within the maximum distance is returned
+// - Comparison ignores case
+// - Names more than the maximum distance away are not suggested
+// - An exact case-insensitive match means there is nothing to suggest
+func TestClosestName(t *testing.T) {
+	names := []string{"To Do", "In Progress", "In Review", "Done"}
+
+	tests := []struct {
+		name     string
+		target   string
+		expected string
+		found    bool
+	}{
+		{"Given a missing letter When suggesting Then the intended name is returned", "In Progres", "In Progress", true},
+		{"Given a different case and a typo When suggesting Then case is ignored", "in reveiw", "In Review", true},
+		{"Given a value far from every name When suggesting Then nothing is returned", "Backlog", "", false},
+		{"Given an exact match in another case When suggesting Then nothing is returned", "done", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := ClosestName(tt.target, names, 2)
+			if got != tt.expected || found != tt.found {
+				t.Errorf("Expected (%q, %v), got (%q, %v)", tt.expected, tt.found, got, found)
+			}
+		})
+	}
+
+	t.Run("Given known word pairs When measuring distance Then the edit count is returned", func(t *testing.T) {
+		pairs := []struct {
+			a, b     string
+			distance int
+		}{
+			{"kitten", "sitting", 3},
+			{"", "abc", 3},
+			{"same", "same", 0},
+			{"straße", "strasse", 2},
+		}
+		for _, pair := range pairs {
+			if got := levenshtein(pair.a, pair.b); got != pair.distance {
+				t.Errorf("levenshtein(%q, %q) = %d, expected %d", pair.a, pair.b, got, pair.distance)
+			}
+		}
+	})
+}
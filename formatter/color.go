@@ -0,0 +1,169 @@
+package formatter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Theme presets selectable with --theme or the theme config key
+const (
+	ThemeDark  = "dark"
+	ThemeLight = "light"
+	ThemeNone  = "none"
+)
+
+// Theme element names usable as keys in the colors config map
+const (
+	ColorElementID      = "id"
+	ColorElementBin     = "bin"
+	ColorElementOverdue = "overdue"
+)
+
+const colorReset = "\033[0m"
+
+// colorCodes maps the supported color names to their ANSI escape codes
+var colorCodes = map[string]string{
+	"black":   "\033[30m",
+	"red":     "\033[31m",
+	"green":   "\033[32m",
+	"yellow":  "\033[33m",
+	"blue":    "\033[34m",
+	"magenta": "\033[35m",
+	"cyan":    "\033[36m",
+	"white":   "\033[37m",
+	"gray":    "\033[90m",
+	"bold":    "\033[1m",
+	"dim":     "\033[2m",
+	"none":    "",
+}
+
+// themePresets holds the element colors for each named preset
+var themePresets = map[string]Theme{
+	ThemeDark:  {ID: "bold", Bin: "cyan", Overdue: "red"},
+	ThemeLight: {ID: "bold", Bin: "blue", Overdue: "red"},
+}
+
+// Theme maps output elements to color names
+type Theme struct {
+	ID      string
+	Bin     string
+	Overdue string
+}
+
+// BuildTheme returns the named preset with any per-element color overrides applied.
+// An empty preset name selects the dark preset. The "none" preset disables color and returns nil.
+func BuildTheme(preset string, overrides map[string]string) (*Theme, error) {
+	if err := ValidateThemeName(preset); err != nil {
+		return nil, err
+	}
+	if err := ValidateColors(overrides); err != nil {
+		return nil, err
+	}
+
+	if preset == ThemeNone {
+		return nil, nil
+	}
+	if preset == "" {
+		preset = ThemeDark
+	}
+
+	theme := themePresets[preset]
+	for element, color := range overrides {
+		switch element {
+		case ColorElementID:
+			theme.ID = color
+		case ColorElementBin:
+			theme.Bin = color
+		case ColorElementOverdue:
+			theme.Overdue = color
+		}
+	}
+	return &theme, nil
+}
+
+// ValidateThemeName checks that name is a known theme preset (or empty for the default)
+func ValidateThemeName(name string) error {
+	switch name {
+	case "", ThemeDark, ThemeLight, ThemeNone:
+		return nil
+	}
+	return fmt.Errorf("unknown theme '%s' (valid themes: %s, %s, %s)", name, ThemeDark, ThemeLight, ThemeNone)
+}
+
+// ValidateColors checks that every element and color name in a colors map is known
+func ValidateColors(colors map[string]string) error {
+	for element, color := range colors {
+		switch element {
+		case ColorElementID, ColorElementBin, ColorElementOverdue:
+		default:
+			return fmt.Errorf("unknown color element '%s' (valid elements: %s, %s, %s)",
+				element, ColorElementID, ColorElementBin, ColorElementOverdue)
+		}
+		if _, err := ColorCode(color); err != nil {
+			return fmt.Errorf("invalid color for '%s': %w", element, err)
+		}
+	}
+	return nil
+}
+
+// ColorCode returns the ANSI escape code for a color name
+func ColorCode(name string) (string, error) {
+	code, ok := colorCodes[strings.ToLower(name)]
+	if !ok {
+		return "", fmt.Errorf("unknown color '%s' (valid colors: %s)", name, strings.Join(colorNames(), ", "))
+	}
+	return code, nil
+}
+
+// colorNames returns the supported color names in sorted order
+func colorNames() []string {
+	names := make([]string, 0, len(colorCodes))
+	for name := range colorCodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// colorize wraps text in the ANSI code for the given color name.
+// Text is returned unchanged for an empty or unknown color.
+func colorize(text, color string) string {
+	code, err := ColorCode(color)
+	if err != nil || code == "" || text == "" {
+		return text
+	}
+	return code + text + colorReset
+}
+
+// styleID colors a ticket ID according to the theme
+func (t *Theme) styleID(id string) string {
+	if t == nil {
+		return id
+	}
+	return colorize(id, t.ID)
+}
+
+// styleBin colors a bin/status name according to the theme
+func (t *Theme) styleBin(bin string) string {
+	if t == nil {
+		return bin
+	}
+	return colorize(bin, t.Bin)
+}
+
+// StyleDim dims secondary text such as footers when a theme is set
+func (t *Theme) StyleDim(text string) string {
+	if t == nil {
+		return text
+	}
+	return colorize(text, "dim")
+}
+
+// styleOverdue colors an overdue due date according to the theme
+func (t *Theme) styleOverdue(date string) string {
+	if t == nil {
+		return date
+	}
+	return colorize(date, t.Overdue)
+}
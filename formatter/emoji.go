@@ -0,0 +1,49 @@
+package formatter
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	zeroWidthJoiner   = '\u200d'
+	variationSelector = '\ufe0f'
+)
+
+// stripEmoji removes emoji and other symbol-class runes from text, along with the
+// joiners, variation selectors, and modifiers that combine them. Runs of spaces left
+// behind are collapsed so the remaining words stay evenly spaced.
+func stripEmoji(text string) string {
+	stripped := strings.Map(func(r rune) rune {
+		if isEmojiRune(r) {
+			return -1
+		}
+		return r
+	}, text)
+
+	if stripped == text {
+		return text
+	}
+	return strings.Join(strings.Fields(stripped), " ")
+}
+
+// isEmojiRune reports whether r is an emoji, a pictographic symbol, or an emoji modifier
+func isEmojiRune(r rune) bool {
+	switch {
+	case r == zeroWidthJoiner, r == variationSelector:
+		return true
+	case unicode.Is(unicode.So, r), unicode.Is(unicode.Sk, r) && r > unicode.MaxLatin1:
+		return true
+	case unicode.Is(unicode.Me, r):
+		return true
+	}
+	return false
+}
+
+// displayText prepares free text (names, descriptions) for human-readable output
+func (opts Options) displayText(text string) string {
+	if opts.NoEmoji {
+		return stripEmoji(text)
+	}
+	return text
+}
@@ -0,0 +1,121 @@
+package formatter
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// FormatTicketsCSV formats tickets as CSV with a header row and the default columns.
+// An empty ticket list produces the header row only.
+func FormatTicketsCSV(tickets []models.Ticket) (string, error) {
+	return FormatTicketsCSVFields(tickets, DefaultFields)
+}
+
+// FormatTicketsCSVFields formats tickets as CSV with only the selected fields, in the given order.
+// Values are escaped by encoding/csv so commas, quotes, and newlines survive a round trip.
+func FormatTicketsCSVFields(tickets []models.Ticket, fields []string) (string, error) {
+	if err := ValidateFields(fields); err != nil {
+		return "", err
+	}
+
+	var builder strings.Builder
+	writer := csv.NewWriter(&builder)
+	if err := writer.Write(fieldColumns(fields)); err != nil {
+		return "", fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, ticket := range tickets {
+		if err := writer.Write(fieldValues(ticket, fields)); err != nil {
+			return "", fmt.Errorf("failed to write CSV row for ticket %s: %w", ticket.ID, err)
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return "", fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return builder.String(), nil
+}
+
+// JSONSchemaVersion is the schema_version reported in the JSON envelope. Bump it whenever a
+// change could break an existing consumer: a renamed or removed key, or a changed value
+// format. Adding a new field that must be selected with --fields does not need a bump.
+const JSONSchemaVersion = 1
+
+// FormatTicketsJSON formats tickets as a JSON array of objects containing only the
+// selected fields. Keys appear in the order the fields were selected.
+func FormatTicketsJSON(tickets []models.Ticket, fields []string) (string, error) {
+	if err := ValidateFields(fields); err != nil {
+		return "", err
+	}
+
+	var buffer bytes.Buffer
+	if err := writeTicketsJSONArray(&buffer, tickets, fields, ""); err != nil {
+		return "", err
+	}
+	buffer.WriteString("\n")
+	return buffer.String(), nil
+}
+
+// FormatTicketsJSONEnvelope formats tickets like FormatTicketsJSON, wrapped in an object
+// with the JSONSchemaVersion so consumers can detect format changes:
+// {"schema_version": 1, "tickets": [...]}
+func FormatTicketsJSONEnvelope(tickets []models.Ticket, fields []string) (string, error) {
+	if err := ValidateFields(fields); err != nil {
+		return "", err
+	}
+
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "{\n  \"schema_version\": %d,\n  \"tickets\": ", JSONSchemaVersion)
+	if err := writeTicketsJSONArray(&buffer, tickets, fields, "  "); err != nil {
+		return "", err
+	}
+	buffer.WriteString("\n}\n")
+	return buffer.String(), nil
+}
+
+// writeTicketsJSONArray writes tickets as a JSON array with one object per line, each line
+// after the opening bracket prefixed with indent
+func writeTicketsJSONArray(buffer *bytes.Buffer, tickets []models.Ticket, fields []string, indent string) error {
+	columns := fieldColumns(fields)
+	buffer.WriteString("[")
+	for i, ticket := range tickets {
+		if i > 0 {
+			buffer.WriteString(",")
+		}
+		buffer.WriteString("\n" + indent + "  {")
+		for j, value := range fieldValues(ticket, fields) {
+			if j > 0 {
+				buffer.WriteString(", ")
+			}
+			if err := writeJSONPair(buffer, columns[j], value); err != nil {
+				return err
+			}
+		}
+		buffer.WriteString("}")
+	}
+	if len(tickets) > 0 {
+		buffer.WriteString("\n" + indent)
+	}
+	buffer.WriteString("]")
+	return nil
+}
+
+// writeJSONPair writes a single "key": "value" pair with both parts JSON-escaped
+func writeJSONPair(buffer *bytes.Buffer, key, value string) error {
+	encodedKey, err := json.Marshal(key)
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON key %s: %w", key, err)
+	}
+	encodedValue, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON value for %s: %w", key, err)
+	}
+	buffer.Write(encodedKey)
+	buffer.WriteString(": ")
+	buffer.Write(encodedValue)
+	return nil
+}
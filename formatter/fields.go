@@ -0,0 +1,90 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// Ticket field names accepted by --fields
+const (
+	FieldID          = "id"
+	FieldName        = "name"
+	FieldBin         = "bin"
+	FieldCreated     = "created"
+	FieldUpdated     = "updated"
+	FieldDue         = "due"
+	FieldDescription = "description"
+)
+
+// DefaultFields is the field set, in order, used when no --fields selection is given
+var DefaultFields = []string{FieldID, FieldName, FieldBin, FieldCreated, FieldUpdated, FieldDue, FieldDescription}
+
+// ticketField describes how a selectable field is named in CSV/JSON output and read from a ticket
+type ticketField struct {
+	column string
+	value  func(models.Ticket) string
+}
+
+// ticketFields is the registry of selectable fields shared by every output mode
+var ticketFields = map[string]ticketField{
+	FieldID:          {column: "id", value: func(t models.Ticket) string { return t.ID }},
+	FieldName:        {column: "name", value: func(t models.Ticket) string { return t.Name }},
+	FieldBin:         {column: "bin_name", value: func(t models.Ticket) string { return t.BinName }},
+	FieldCreated:     {column: "created", value: models.Ticket.FormattedCreatedDate},
+	FieldUpdated:     {column: "updated", value: models.Ticket.FormattedUpdatedDate},
+	FieldDue:         {column: "due", value: models.Ticket.FormattedDueDate},
+	FieldDescription: {column: "description", value: func(t models.Ticket) string { return t.Description }},
+}
+
+// ParseFields parses a comma-separated --fields value into a validated list of field names.
+// An empty value selects DefaultFields.
+func ParseFields(value string) ([]string, error) {
+	var fields []string
+	for _, field := range strings.Split(value, ",") {
+		if field = strings.ToLower(strings.TrimSpace(field)); field != "" {
+			fields = append(fields, field)
+		}
+	}
+	if len(fields) == 0 {
+		return DefaultFields, nil
+	}
+	if err := ValidateFields(fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// ValidateFields checks that every field name is known and appears only once
+func ValidateFields(fields []string) error {
+	seen := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		if _, ok := ticketFields[field]; !ok {
+			return fmt.Errorf("unknown field '%s' (valid fields: %s)", field, strings.Join(DefaultFields, ", "))
+		}
+		if seen[field] {
+			return fmt.Errorf("field '%s' is listed more than once", field)
+		}
+		seen[field] = true
+	}
+	return nil
+}
+
+// fieldColumns returns the CSV/JSON column names for the selected fields
+func fieldColumns(fields []string) []string {
+	columns := make([]string, len(fields))
+	for i, field := range fields {
+		columns[i] = ticketFields[field].column
+	}
+	return columns
+}
+
+// fieldValues returns a ticket's values for the selected fields, in order
+func fieldValues(ticket models.Ticket, fields []string) []string {
+	values := make([]string, len(fields))
+	for i, field := range fields {
+		values[i] = ticketFields[field].value(ticket)
+	}
+	return values
+}
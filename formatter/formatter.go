@@ -3,6 +3,7 @@ package formatter
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/Germanicus1/fb/models"
 )
@@ -14,7 +15,10 @@ const (
 	descriptionIndent           = "    "                      // 4 spaces for wrapped lines
 	emptyDescriptionPlaceholder = "(none)"                    // Placeholder for empty descriptions
 	noTicketsMessage            = "No tickets assigned to you."
+	noTicketsInBinFormat        = "No tickets assigned to you in bin '%s'."
 	ticketCountHeaderFormat     = "Found %d ticket(s) assigned to you:\n\n"
+	limitedCountHeaderFormat    = "Found %d of %d ticket(s) assigned to you:\n\n"
+	overdueMarker               = " (OVERDUE)"
 )
 
 // FormatTicket formats a single ticket for display in the terminal
@@ -32,63 +36,121 @@ func FormatTicket(ticket models.Ticket) string {
 	return builder.String()
 }
 
+// Options controls optional formatting behavior for ticket lists
+type Options struct {
+	Verbose bool      // Show full details instead of one line per ticket
+	Theme   *Theme    // Colors to apply; nil for plain output
+	Now     time.Time // Reference time for date-relative output; zero means time.Now()
+	NoEmoji bool      // Strip emoji and other symbols from names and descriptions
+	Fields  []string  // Fields shown on each minimal line; empty for the default "[id] name"
+	Width   int       // Line width descriptions wrap to; zero means 80 columns
+	Quiet   bool      // Omit the "Found N ticket(s)" header and its blank line, e.g. for scripts
+	WithBin bool      // Append "(Bin)" to default minimal lines for tickets that have a bin
+
+	EmptyMessage string // Replaces "No tickets assigned to you." when there are no tickets
+	BinName      string // Bin the list was filtered to, known to exist; named in the empty message
+	Total        int    // Ticket count before a limit was applied; when above len(tickets) the header reads "N of M"
+}
+
 // FormatTickets formats tickets for display in the terminal with full details
 func FormatTickets(tickets []models.Ticket) string {
+	return FormatTicketsWithOptions(tickets, Options{Verbose: true})
+}
+
+// FormatTicketsMinimal formats tickets in minimal mode showing only ID and Name
+func FormatTicketsMinimal(tickets []models.Ticket) string {
+	return FormatTicketsWithOptions(tickets, Options{})
+}
+
+// FormatTicketsWithOptions formats tickets in minimal or verbose mode with optional styling
+func FormatTicketsWithOptions(tickets []models.Ticket, opts Options) string {
 	if len(tickets) == 0 {
+		if opts.EmptyMessage != "" {
+			return opts.EmptyMessage
+		}
+		if opts.BinName != "" {
+			return fmt.Sprintf(noTicketsInBinFormat, opts.BinName)
+		}
 		return noTicketsMessage
 	}
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
 
 	var builder strings.Builder
-	writeTicketHeader(&builder, len(tickets))
+	if !opts.Quiet {
+		writeTicketHeader(&builder, len(tickets), opts.Total)
+	}
 
 	for i, ticket := range tickets {
+		if !opts.Verbose {
+			formatMinimalTicketLine(&builder, ticket, opts)
+			continue
+		}
+
 		if i > 0 {
 			builder.WriteString("\n")
 		}
 
-		formatTicketHeader(&builder, ticket)
-		formatTicketStatus(&builder, ticket)
-		formatTicketDates(&builder, ticket)
-		formatTicketDescription(&builder, ticket)
+		formatTicketHeader(&builder, ticket, opts)
+		formatTicketStatus(&builder, ticket, opts)
+		formatTicketDates(&builder, ticket, opts)
+		formatTicketDescription(&builder, ticket, opts, false)
 	}
 
 	return builder.String()
 }
 
-// FormatTicketsMinimal formats tickets in minimal mode showing only ID and Name
-func FormatTicketsMinimal(tickets []models.Ticket) string {
-	if len(tickets) == 0 {
-		return noTicketsMessage
-	}
-
-	var builder strings.Builder
-	writeTicketHeader(&builder, len(tickets))
-
-	for _, ticket := range tickets {
-		formatMinimalTicketLine(&builder, ticket)
+// writeTicketHeader writes the standard header showing ticket count, or "N of M" when
+// only the first count of total tickets are shown
+func writeTicketHeader(builder *strings.Builder, count, total int) {
+	if total > count {
+		builder.WriteString(fmt.Sprintf(limitedCountHeaderFormat, count, total))
+		return
 	}
-
-	return builder.String()
-}
-
-// writeTicketHeader writes the standard header showing ticket count
-func writeTicketHeader(builder *strings.Builder, count int) {
 	builder.WriteString(fmt.Sprintf(ticketCountHeaderFormat, count))
 }
 
 // formatMinimalTicketLine writes a single ticket in minimal format
-func formatMinimalTicketLine(builder *strings.Builder, ticket models.Ticket) {
-	builder.WriteString(fmt.Sprintf("[%s] %s\n", ticket.ID, ticket.Name))
+func formatMinimalTicketLine(builder *strings.Builder, ticket models.Ticket, opts Options) {
+	if len(opts.Fields) > 0 {
+		formatFieldsTicketLine(builder, ticket, opts)
+		return
+	}
+	line := fmt.Sprintf("[%s] %s", opts.Theme.styleID(ticket.ID), opts.displayText(ticket.Name))
+	if opts.WithBin && ticket.BinName != "" {
+		line += fmt.Sprintf(" (%s)", opts.Theme.styleBin(ticket.BinName))
+	}
+	builder.WriteString(line + "\n")
+}
+
+// formatFieldsTicketLine writes the selected fields of a ticket on one line, separated by " | ".
+// The ID keeps its brackets so lines still look like the default minimal format.
+func formatFieldsTicketLine(builder *strings.Builder, ticket models.Ticket, opts Options) {
+	values := fieldValues(ticket, opts.Fields)
+	for i, field := range opts.Fields {
+		switch field {
+		case FieldID:
+			values[i] = "[" + opts.Theme.styleID(values[i]) + "]"
+		case FieldBin:
+			values[i] = opts.Theme.styleBin(values[i])
+		case FieldName:
+			values[i] = opts.displayText(values[i])
+		case FieldDescription:
+			values[i] = prepareDescription(opts.displayText(values[i]), true)
+		}
+	}
+	writeField(builder, "%s", strings.Join(values, " | "))
 }
 
 // formatTicketHeader writes the ticket ID and name to the builder.
-func formatTicketHeader(builder *strings.Builder, ticket models.Ticket) {
-	writeField(builder, "[%s] %s", ticket.ID, ticket.Name)
+func formatTicketHeader(builder *strings.Builder, ticket models.Ticket, opts Options) {
+	writeField(builder, "[%s] %s", opts.Theme.styleID(ticket.ID), opts.displayText(ticket.Name))
 }
 
 // formatTicketStatus writes the ticket status to the builder.
-func formatTicketStatus(builder *strings.Builder, ticket models.Ticket) {
-	writeIndentedField(builder, "Status", ticket.Status())
+func formatTicketStatus(builder *strings.Builder, ticket models.Ticket, opts Options) {
+	writeIndentedField(builder, "Status", opts.Theme.styleBin(ticket.Status()))
 }
 
 // writeField writes a formatted field to the builder.
@@ -102,10 +164,43 @@ func writeIndentedField(builder *strings.Builder, label, value string) {
 }
 
 // formatTicketDates writes the created, updated, and due dates to the builder.
-func formatTicketDates(builder *strings.Builder, ticket models.Ticket) {
-	writeDateField(builder, "Created", ticket.FormattedCreatedDate())
+// The created and due dates keep their absolute form followed by a relative phrase;
+// overdue dates are also marked, and highlighted when a theme is set.
+func formatTicketDates(builder *strings.Builder, ticket models.Ticket, opts Options) {
+	createdDate := ticket.FormattedCreatedDate()
+	if createdDate != "" {
+		createdDate += formatRelativeDays(-ticket.AgeDaysAt(opts.Now))
+	}
+	writeDateField(builder, "Created", createdDate)
 	writeDateField(builder, "Updated", ticket.FormattedUpdatedDate())
-	writeDateField(builder, "Due", ticket.FormattedDueDate())
+
+	dueDate := ticket.FormattedDueDate()
+	if dueDate != "" {
+		relative := formatRelativeDays(ticket.DaysUntilDueAt(opts.Now))
+		if ticket.IsOverdueAt(opts.Now) {
+			dueDate = opts.Theme.styleOverdue(dueDate) + relative + overdueMarker
+		} else {
+			dueDate += relative
+		}
+	}
+	writeDateField(builder, "Due", dueDate)
+}
+
+// formatRelativeDays renders a signed day offset from today as " (in N days)", " (N days ago)",
+// or " (today)". Positive offsets lie in the future, so a ticket's age is passed negated.
+func formatRelativeDays(days int) string {
+	switch {
+	case days == 0:
+		return " (today)"
+	case days == 1:
+		return " (in 1 day)"
+	case days > 1:
+		return fmt.Sprintf(" (in %d days)", days)
+	case days == -1:
+		return " (1 day ago)"
+	default:
+		return fmt.Sprintf(" (%d days ago)", -days)
+	}
 }
 
 // writeDateField writes a labeled date field to the builder if the date is present.
@@ -116,10 +211,10 @@ func writeDateField(builder *strings.Builder, label, date string) {
 }
 
 // formatTicketDescription writes the ticket description to the builder.
-// Long descriptions are word-wrapped to multiple lines.
-// Empty descriptions are shown as "(none)".
-func formatTicketDescription(builder *strings.Builder, ticket models.Ticket) {
-	description := prepareDescription(ticket.Description)
+// Long descriptions are word-wrapped to multiple lines, and cut at maxDescriptionLength
+// only when truncate is set. Empty descriptions are shown as "(none)".
+func formatTicketDescription(builder *strings.Builder, ticket models.Ticket, opts Options, truncate bool) {
+	description := prepareDescription(opts.displayText(ticket.Description), truncate)
 	descriptionLabel := fieldIndent + "Description: "
 
 	// Handle empty descriptions by showing placeholder
@@ -129,7 +224,7 @@ func formatTicketDescription(builder *strings.Builder, ticket models.Ticket) {
 	}
 
 	// Calculate available width for description text (account for label and indent)
-	availableWidth := maxLineWidth - len(descriptionLabel)
+	availableWidth := opts.lineWidth() - len(descriptionLabel)
 
 	// Wrap the description text to fit within available width
 	wrappedLines := wrapText(description, availableWidth)
@@ -147,13 +242,25 @@ func formatTicketDescription(builder *strings.Builder, ticket models.Ticket) {
 	}
 }
 
-// prepareDescription prepares a description for display by trimming, truncating, and normalizing.
-func prepareDescription(description string) string {
+// lineWidth returns the width to wrap output to, falling back to maxLineWidth when unset.
+// Widths too narrow to fit the description label after indentation also fall back.
+func (opts Options) lineWidth() int {
+	if opts.Width <= len(fieldIndent+"Description: ") {
+		return maxLineWidth
+	}
+	return opts.Width
+}
+
+// prepareDescription prepares a description for display by trimming, normalizing, and,
+// when truncate is set, truncating it.
+func prepareDescription(description string, truncate bool) string {
 	description = strings.TrimSpace(description)
 	if description == "" {
 		return ""
 	}
-	description = truncateDescription(description)
+	if truncate {
+		description = truncateDescription(description)
+	}
 	return normalizeWhitespace(description)
 }
 
@@ -0,0 +1,58 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestTicketAge tests the relative age annotation on the verbose Created line
+//
+// Acceptance Criteria:
+// - AgeDays counts calendar days since creation, ignoring the time of day
+// - The Created line reads "(N days ago)", "(1 day ago)", or "(today)" after the absolute date
+// - Tickets without a created date have no Created line and no annotation
+func TestTicketAge(t *testing.T) {
+	now := time.Date(2026, 2, 16, 9, 0, 0, 0, time.UTC)
+
+	render := func(created time.Time) string {
+		tickets := []models.Ticket{{ID: "T1", Name: "Ticket", CreatedAt: created}}
+		return FormatTicketsWithOptions(tickets, Options{Verbose: true, Now: now})
+	}
+
+	tests := []struct {
+		name     string
+		created  time.Time
+		age      int
+		expected string
+	}{
+		{"Given a ticket created 32 days ago When formatting Then it reads 32 days ago", time.Date(2026, 1, 15, 23, 0, 0, 0, time.UTC), 32, "  Created: 2026-01-15 (32 days ago)\n"},
+		{"Given a ticket created yesterday When formatting Then it reads 1 day ago", time.Date(2026, 2, 15, 8, 0, 0, 0, time.UTC), 1, "  Created: 2026-02-15 (1 day ago)\n"},
+		{"Given a ticket created today When formatting Then it reads today", time.Date(2026, 2, 16, 1, 0, 0, 0, time.UTC), 0, "  Created: 2026-02-16 (today)\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ticket := models.Ticket{CreatedAt: tt.created}
+			if got := ticket.AgeDaysAt(now); got != tt.age {
+				t.Errorf("Expected AgeDaysAt = %d, got %d", tt.age, got)
+			}
+
+			if output := render(tt.created); !strings.Contains(output, tt.expected) {
+				t.Errorf("Expected %q, got:\n%s", tt.expected, output)
+			}
+		})
+	}
+
+	t.Run("Given no created date When formatting Then there is no age annotation", func(t *testing.T) {
+		if got := (models.Ticket{}).AgeDaysAt(now); got != 0 {
+			t.Errorf("Expected 0 days for a missing created date, got %d", got)
+		}
+
+		if output := render(time.Time{}); strings.Contains(output, "Created:") || strings.Contains(output, "ago") {
+			t.Errorf("Expected no Created line, got:\n%s", output)
+		}
+	})
+}
@@ -0,0 +1,86 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestColorTheme tests color theme configuration
+//
+// User Story:
+// As a user with a light or dark terminal, I want to choose a color theme or
+// customize individual colors, so that output is readable on my background.
+//
+// Acceptance Criteria:
+// - Presets dark, light, and none are available
+// - Per-element overrides map to the expected ANSI codes
+// - Invalid color or element names produce a clear error
+// - The none preset disables color entirely
+func TestColorTheme(t *testing.T) {
+	t.Run("Given a configured id color When building a theme Then the ID is wrapped in that ANSI code", func(t *testing.T) {
+		theme, err := BuildTheme(ThemeDark, map[string]string{"id": "cyan"})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		output := FormatTicketsWithOptions([]models.Ticket{{ID: "T1", Name: "Ticket"}}, Options{Theme: theme})
+
+		if !strings.Contains(output, "\033[36mT1\033[0m") {
+			t.Errorf("Expected ID wrapped in cyan ANSI code, got %q", output)
+		}
+	})
+
+	t.Run("Given the dark preset When formatting an overdue ticket Then the due date is red", func(t *testing.T) {
+		theme, _ := BuildTheme(ThemeDark, nil)
+		now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+		tickets := []models.Ticket{{ID: "T1", Name: "Late", BinName: "Doing", DueDate: now.AddDate(0, 0, -1)}}
+
+		output := FormatTicketsWithOptions(tickets, Options{Verbose: true, Theme: theme, Now: now})
+
+		if !strings.Contains(output, "Due: \033[31m2026-03-09\033[0m") {
+			t.Errorf("Expected overdue date in red, got %q", output)
+		}
+		if !strings.Contains(output, "Status: \033[36mDoing\033[0m") {
+			t.Errorf("Expected bin in cyan, got %q", output)
+		}
+	})
+
+	t.Run("Given an invalid color name When building a theme Then return a clear error", func(t *testing.T) {
+		_, err := BuildTheme(ThemeDark, map[string]string{"bin": "purplish"})
+
+		if err == nil {
+			t.Fatal("Expected error for invalid color")
+		}
+		if !strings.Contains(err.Error(), "purplish") {
+			t.Errorf("Expected error to name the invalid color, got: %v", err)
+		}
+	})
+
+	t.Run("Given an unknown element When building a theme Then return an error", func(t *testing.T) {
+		if _, err := BuildTheme(ThemeDark, map[string]string{"title": "red"}); err == nil {
+			t.Error("Expected error for unknown color element")
+		}
+	})
+
+	t.Run("Given an unknown preset When building a theme Then return an error", func(t *testing.T) {
+		if _, err := BuildTheme("solarized", nil); err == nil {
+			t.Error("Expected error for unknown theme preset")
+		}
+	})
+
+	t.Run("Given the none preset When formatting Then no ANSI codes are emitted", func(t *testing.T) {
+		theme, err := BuildTheme(ThemeNone, map[string]string{"id": "cyan"})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		output := FormatTicketsWithOptions([]models.Ticket{{ID: "T1", Name: "Ticket"}}, Options{Theme: theme})
+
+		if strings.Contains(output, "\033[") {
+			t.Errorf("Expected plain output, got %q", output)
+		}
+	})
+}
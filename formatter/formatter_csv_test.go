@@ -0,0 +1,50 @@
+package formatter
+
+import (
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestFormatTicketsCSVRoundTrip tests the default CSV export used by fb --csv
+//
+// Acceptance Criteria:
+// - Every default column is written, with YYYY-MM-DD dates and empty cells for zero dates
+// - Commas, quotes, and newlines in descriptions survive a round trip through encoding/csv
+func TestFormatTicketsCSVRoundTrip(t *testing.T) {
+	t.Run("Given a description with commas, quotes, and newlines When exporting CSV Then it reads back unchanged", func(t *testing.T) {
+		description := "Steps: open, click \"Save\",\nthen reload\r\nand check"
+		tickets := []models.Ticket{{
+			ID:          "TICKET-1",
+			Name:        "Save, then reload",
+			BinName:     "Doing",
+			Description: description,
+			CreatedAt:   time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC),
+			UpdatedAt:   time.Date(2026, 1, 6, 11, 0, 0, 0, time.UTC),
+		}}
+
+		output, err := FormatTicketsCSV(tickets)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		records, err := csv.NewReader(strings.NewReader(output)).ReadAll()
+		if err != nil {
+			t.Fatalf("Expected valid CSV, got %v:\n%s", err, output)
+		}
+		if len(records) != 2 {
+			t.Fatalf("Expected header and one row, got %d rows:\n%s", len(records), output)
+		}
+
+		// encoding/csv normalizes \r\n inside quoted fields to \n
+		expected := []string{"TICKET-1", "Save, then reload", "Doing", "2026-01-05", "2026-01-06", "", strings.ReplaceAll(description, "\r\n", "\n")}
+		for i, want := range expected {
+			if records[1][i] != want {
+				t.Errorf("Column %s: expected %q, got %q", records[0][i], want, records[1][i])
+			}
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestRelativeDueDate tests the relative annotation on the verbose Due line
+//
+// Acceptance Criteria:
+// - DaysUntilDue is a signed calendar-day count, ignoring the time of day
+// - Future dates read "(in N days)", past dates "(N days ago)", and today "(today)"
+// - The absolute date stays first on the line
+// - Tickets without a due date have no Due line
+func TestRelativeDueDate(t *testing.T) {
+	now := time.Date(2026, 2, 24, 18, 0, 0, 0, time.UTC)
+
+	render := func(due time.Time) string {
+		tickets := []models.Ticket{{ID: "T1", Name: "Ticket", DueDate: due}}
+		return FormatTicketsWithOptions(tickets, Options{Verbose: true, Now: now})
+	}
+
+	tests := []struct {
+		name     string
+		due      time.Time
+		days     int
+		expected string
+	}{
+		{"Given a due date in 5 days When formatting Then it reads in 5 days", time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC), 5, "  Due: 2026-03-01 (in 5 days)\n"},
+		{"Given a due date tomorrow When formatting Then it reads in 1 day", time.Date(2026, 2, 25, 1, 0, 0, 0, time.UTC), 1, "  Due: 2026-02-25 (in 1 day)\n"},
+		{"Given a due date today When formatting Then it reads today", time.Date(2026, 2, 24, 8, 0, 0, 0, time.UTC), 0, "  Due: 2026-02-24 (today)\n"},
+		{"Given a due date 3 days ago When formatting Then it reads 3 days ago", time.Date(2026, 2, 21, 23, 0, 0, 0, time.UTC), -3, "  Due: 2026-02-21 (3 days ago) (OVERDUE)\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ticket := models.Ticket{DueDate: tt.due}
+			if got := ticket.DaysUntilDueAt(now); got != tt.days {
+				t.Errorf("Expected DaysUntilDueAt = %d, got %d", tt.days, got)
+			}
+
+			if output := render(tt.due); !strings.Contains(output, tt.expected) {
+				t.Errorf("Expected %q, got:\n%s", tt.expected, output)
+			}
+		})
+	}
+
+	t.Run("Given no due date When formatting Then there is no relative annotation", func(t *testing.T) {
+		if got := (models.Ticket{}).DaysUntilDueAt(now); got != 0 {
+			t.Errorf("Expected 0 days for a missing due date, got %d", got)
+		}
+
+		if output := render(time.Time{}); strings.Contains(output, "Due:") || strings.Contains(output, "today") {
+			t.Errorf("Expected no Due line, got:\n%s", output)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestNoEmojiOption tests the opt-in --no-emoji emoji stripping
+//
+// Acceptance Criteria:
+// - With NoEmoji, emoji are removed from names and descriptions
+// - Without it, emoji are preserved (TestStory4_4_EmojisPreserved remains the default)
+// - Surrounding words stay evenly spaced after stripping
+func TestNoEmojiOption(t *testing.T) {
+	tickets := []models.Ticket{
+		{
+			ID:          "EMOJI-1",
+			Name:        "Fix bug 🐛 in login",
+			BinName:     "To Do",
+			Description: "This is urgent! ⚠️ Need to fix ASAP ✅ 👍🏽",
+		},
+	}
+
+	t.Run("Given NoEmoji When formatting verbose output Then emoji are removed", func(t *testing.T) {
+		output := FormatTicketsWithOptions(tickets, Options{Verbose: true, NoEmoji: true})
+
+		for _, emoji := range []string{"🐛", "⚠", "✅", "👍", "🏽", "️"} {
+			if strings.Contains(output, emoji) {
+				t.Errorf("Expected %q to be stripped, got:\n%s", emoji, output)
+			}
+		}
+		if !strings.Contains(output, "[EMOJI-1] Fix bug in login") {
+			t.Errorf("Expected name with evenly spaced words, got:\n%s", output)
+		}
+		if !strings.Contains(output, "This is urgent! Need to fix ASAP") {
+			t.Errorf("Expected description text to remain, got:\n%s", output)
+		}
+	})
+
+	t.Run("Given NoEmoji When formatting minimal output Then emoji are removed from names", func(t *testing.T) {
+		output := FormatTicketsWithOptions(tickets, Options{NoEmoji: true})
+
+		if !strings.Contains(output, "[EMOJI-1] Fix bug in login\n") {
+			t.Errorf("Expected stripped minimal line, got:\n%s", output)
+		}
+	})
+
+	t.Run("Given default options When formatting Then emoji are preserved", func(t *testing.T) {
+		output := FormatTicketsWithOptions(tickets, Options{Verbose: true})
+
+		if !strings.Contains(output, "🐛") || !strings.Contains(output, "✅") {
+			t.Errorf("Expected emoji to be preserved by default, got:\n%s", output)
+		}
+	})
+
+	t.Run("Given text without emoji When stripping Then it is unchanged", func(t *testing.T) {
+		text := "Café – naïve résumé (v2.0) ^ 100%"
+		if got := stripEmoji(text); got != text {
+			t.Errorf("Expected %q unchanged, got %q", text, got)
+		}
+	})
+}
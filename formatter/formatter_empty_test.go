@@ -270,3 +270,40 @@ func TestStory1_3_EmptyListMessageIsAccessible(t *testing.T) {
 		t.Error("Message should say 'No tickets' rather than just 'empty'")
 	}
 }
+
+// TestEmptyListInValidBin verifies the wording when a valid bin filter matched no tickets
+// Acceptance Criterion: When `fb --bin "In Progress"` names an existing bin with none of my tickets,
+// I see "No tickets assigned to you in bin 'In Progress'." instead of the generic message
+func TestEmptyListInValidBin(t *testing.T) {
+	t.Run("Given a valid bin with no tickets When formatting Then the bin is named", func(t *testing.T) {
+		output := FormatTicketsWithOptions([]models.Ticket{}, Options{BinName: "In Progress"})
+
+		if output != "No tickets assigned to you in bin 'In Progress'." {
+			t.Errorf("Expected bin-specific message, got: %q", output)
+		}
+	})
+
+	t.Run("Given a valid bin with no tickets When formatting verbose output Then the same message is shown", func(t *testing.T) {
+		output := FormatTicketsWithOptions([]models.Ticket{}, Options{Verbose: true, BinName: "In Progress"})
+
+		if output != "No tickets assigned to you in bin 'In Progress'." {
+			t.Errorf("Expected bin-specific message, got: %q", output)
+		}
+	})
+
+	t.Run("Given a custom empty message and a bin When formatting Then the custom message wins", func(t *testing.T) {
+		output := FormatTicketsWithOptions([]models.Ticket{}, Options{BinName: "In Progress", EmptyMessage: "All clear!"})
+
+		if output != "All clear!" {
+			t.Errorf("Expected custom message, got: %q", output)
+		}
+	})
+
+	t.Run("Given a bin filter with tickets When formatting Then the normal header is shown", func(t *testing.T) {
+		output := FormatTicketsWithOptions([]models.Ticket{{ID: "T-1", Name: "One"}}, Options{BinName: "In Progress"})
+
+		if !strings.Contains(output, "Found 1 ticket(s) assigned to you:") {
+			t.Errorf("Expected normal header, got: %q", output)
+		}
+	})
+}
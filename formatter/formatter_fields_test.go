@@ -0,0 +1,134 @@
+package formatter
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestFieldProjection tests --fields selection across CSV, JSON, and plain output
+//
+// Acceptance Criteria:
+// - In CSV, --fields selects and reorders columns
+// - In JSON, --fields emits objects with only the chosen keys
+// - An unknown field errors the same way in every mode
+func TestFieldProjection(t *testing.T) {
+	tickets := []models.Ticket{
+		{
+			ID:          "TICKET-1",
+			Name:        "Fix login, then \"deploy\"",
+			BinName:     "Doing",
+			Description: "Line one\nLine two",
+			CreatedAt:   time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC),
+			DueDate:     time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{ID: "TICKET-2", Name: "Write docs", BinName: "Backlog"},
+	}
+
+	t.Run("Given selected fields When formatting CSV Then only those columns appear in that order", func(t *testing.T) {
+		output, err := FormatTicketsCSVFields(tickets, []string{FieldDue, FieldID, FieldName})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		records, err := csv.NewReader(strings.NewReader(output)).ReadAll()
+		if err != nil {
+			t.Fatalf("Expected valid CSV, got %v:\n%s", err, output)
+		}
+		expected := [][]string{
+			{"due", "id", "name"},
+			{"2026-02-01", "TICKET-1", "Fix login, then \"deploy\""},
+			{"", "TICKET-2", "Write docs"},
+		}
+		if len(records) != len(expected) {
+			t.Fatalf("Expected %d rows, got %d:\n%s", len(expected), len(records), output)
+		}
+		for i := range expected {
+			if strings.Join(records[i], "|") != strings.Join(expected[i], "|") {
+				t.Errorf("Row %d: expected %q, got %q", i, expected[i], records[i])
+			}
+		}
+	})
+
+	t.Run("Given default fields When formatting CSV Then the full header is written", func(t *testing.T) {
+		output, err := FormatTicketsCSV(nil)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if output != "id,name,bin_name,created,updated,due,description\n" {
+			t.Errorf("Expected header only, got %q", output)
+		}
+	})
+
+	t.Run("Given selected fields When formatting JSON Then objects contain only those keys", func(t *testing.T) {
+		output, err := FormatTicketsJSON(tickets, []string{FieldID, FieldBin, FieldDescription})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		var decoded []map[string]string
+		if err := json.Unmarshal([]byte(output), &decoded); err != nil {
+			t.Fatalf("Expected valid JSON, got %v:\n%s", err, output)
+		}
+		if len(decoded) != 2 {
+			t.Fatalf("Expected 2 objects, got %d", len(decoded))
+		}
+		first := decoded[0]
+		if len(first) != 3 || first["id"] != "TICKET-1" || first["bin_name"] != "Doing" || first["description"] != "Line one\nLine two" {
+			t.Errorf("Unexpected object: %v", first)
+		}
+		if _, ok := first["name"]; ok {
+			t.Errorf("Expected name to be omitted, got %v", first)
+		}
+		if strings.Index(output, `"id"`) > strings.Index(output, `"bin_name"`) {
+			t.Errorf("Expected keys in selection order, got:\n%s", output)
+		}
+	})
+
+	t.Run("Given no tickets When formatting JSON Then an empty array is written", func(t *testing.T) {
+		output, err := FormatTicketsJSON(nil, DefaultFields)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if output != "[]\n" {
+			t.Errorf("Expected empty array, got %q", output)
+		}
+	})
+
+	t.Run("Given selected fields When formatting minimal output Then each line shows those fields", func(t *testing.T) {
+		output := FormatTicketsWithOptions(tickets[:1], Options{Fields: []string{FieldID, FieldDue}})
+
+		if !strings.Contains(output, "[TICKET-1] | 2026-02-01\n") {
+			t.Errorf("Expected projected minimal line, got:\n%s", output)
+		}
+	})
+
+	t.Run("Given an unknown field When parsing or formatting Then every mode returns the same error", func(t *testing.T) {
+		_, parseErr := ParseFields("id,assignee")
+		_, csvErr := FormatTicketsCSVFields(tickets, []string{"id", "assignee"})
+		_, jsonErr := FormatTicketsJSON(tickets, []string{"id", "assignee"})
+
+		for _, err := range []error{parseErr, csvErr, jsonErr} {
+			if err == nil || !strings.Contains(err.Error(), "unknown field 'assignee'") {
+				t.Errorf("Expected unknown field error, got %v", err)
+			}
+		}
+		if parseErr.Error() != csvErr.Error() || csvErr.Error() != jsonErr.Error() {
+			t.Errorf("Expected identical errors, got %q, %q, %q", parseErr, csvErr, jsonErr)
+		}
+	})
+
+	t.Run("Given a field list with spaces and mixed case When parsing Then it is normalized", func(t *testing.T) {
+		fields, err := ParseFields(" ID, Due ,name")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if strings.Join(fields, ",") != "id,due,name" {
+			t.Errorf("Expected id,due,name, got %v", fields)
+		}
+	})
+}
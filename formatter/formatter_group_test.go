@@ -0,0 +1,114 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestFormatTicketsGroupedByAssignee tests the --group-by assignee view
+//
+// User Story:
+// As a lead, I want to see my team's tickets grouped by assignee,
+// so that I can see who is working on what at a glance.
+//
+// Acceptance Criteria:
+// - Each assignee gets a header with their ticket count
+// - Tickets are listed beneath their assignee in minimal form
+// - Tickets without an assignee go under "(unassigned)", listed last
+// - A ticket with several assignees appears under each of them
+func TestFormatTicketsGroupedByAssignee(t *testing.T) {
+	names := map[string]string{
+		"u-alice": "alice@example.com",
+		"u-bob":   "bob@example.com",
+	}
+	tickets := []models.Ticket{
+		{ID: "T1", Name: "Alice task", AssignedIDs: []string{"u-alice"}},
+		{ID: "T2", Name: "Bob task", AssignedIDs: []string{"u-bob"}},
+		{ID: "T3", Name: "Shared task", AssignedIDs: []string{"u-alice", "u-bob"}},
+		{ID: "T4", Name: "Orphan task"},
+		{ID: "T5", Name: "Alice other", AssignedIDs: []string{"u-alice"}},
+	}
+
+	t.Run("Given tickets across assignees When grouping Then each assignee has a header with its count", func(t *testing.T) {
+		output := FormatTicketsGroupedByAssignee(tickets, names)
+
+		expected := `Found 5 ticket(s) across 2 assignee(s):
+
+alice@example.com (3):
+  [T1] Alice task
+  [T3] Shared task
+  [T5] Alice other
+
+bob@example.com (2):
+  [T2] Bob task
+  [T3] Shared task
+
+(unassigned) (1):
+  [T4] Orphan task
+`
+		if output != expected {
+			t.Errorf("Unexpected grouped output.\nExpected:\n%s\nGot:\n%s", expected, output)
+		}
+	})
+
+	t.Run("Given an assignee without a display name When grouping Then the user ID is used as header", func(t *testing.T) {
+		output := FormatTicketsGroupedByAssignee([]models.Ticket{
+			{ID: "T9", Name: "Someone else", AssignedIDs: []string{"u-carol"}},
+		}, names)
+
+		if !strings.Contains(output, "u-carol (1):") {
+			t.Errorf("Expected header for raw user ID, got:\n%s", output)
+		}
+	})
+
+	t.Run("Given no tickets When grouping Then show the empty message", func(t *testing.T) {
+		output := FormatTicketsGroupedByAssignee([]models.Ticket{}, names)
+
+		if output != noTicketsMessage {
+			t.Errorf("Expected %q, got %q", noTicketsMessage, output)
+		}
+	})
+}
+
+// TestFormatTicketsGroupedByBin tests the --group-by bin view
+//
+// Acceptance Criteria:
+// - The total line comes first, then a "Bin (count):" header per bin sorted by name
+// - Tickets are listed beneath their bin in minimal form
+// - Tickets without a bin go under "(no bin)", listed last
+func TestFormatTicketsGroupedByBin(t *testing.T) {
+	t.Run("Given tickets across bins When grouping Then each bin has a header with its count", func(t *testing.T) {
+		tickets := []models.Ticket{
+			{ID: "T1", Name: "Write tests", BinName: "Doing"},
+			{ID: "T2", Name: "Plan sprint", BinName: "Backlog"},
+			{ID: "T3", Name: "Loose end"},
+			{ID: "T4", Name: "Fix login bug", BinName: "Doing"},
+		}
+
+		output := FormatTicketsGroupedByBin(tickets)
+
+		expected := `Found 4 ticket(s) assigned to you:
+
+Backlog (1):
+  [T2] Plan sprint
+
+Doing (2):
+  [T1] Write tests
+  [T4] Fix login bug
+
+(no bin) (1):
+  [T3] Loose end
+`
+		if output != expected {
+			t.Errorf("Unexpected grouped output.\nExpected:\n%s\nGot:\n%s", expected, output)
+		}
+	})
+
+	t.Run("Given no tickets When grouping Then show the empty message", func(t *testing.T) {
+		if output := FormatTicketsGroupedByBin(nil); output != noTicketsMessage {
+			t.Errorf("Expected %q, got %q", noTicketsMessage, output)
+		}
+	})
+}
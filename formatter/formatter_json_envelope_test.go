@@ -0,0 +1,68 @@
+package formatter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestFormatTicketsJSONEnvelope tests the versioned JSON envelope emitted by --json
+//
+// Acceptance Criteria:
+// - Output is an object with schema_version set to JSONSchemaVersion and a tickets array
+// - The tickets array holds the same objects as the bare array from --json-bare
+// - An empty list gives an empty tickets array, not null
+func TestFormatTicketsJSONEnvelope(t *testing.T) {
+	tickets := []models.Ticket{{ID: "T1", Name: "One", BinName: "Doing"}, {ID: "T2", Name: "Two \"quoted\""}}
+	fields := []string{FieldID, FieldName, FieldBin}
+
+	t.Run("Given tickets When formatting the envelope Then schema_version and tickets are present", func(t *testing.T) {
+		output, err := FormatTicketsJSONEnvelope(tickets, fields)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		var envelope struct {
+			SchemaVersion *int                `json:"schema_version"`
+			Tickets       []map[string]string `json:"tickets"`
+		}
+		if err := json.Unmarshal([]byte(output), &envelope); err != nil {
+			t.Fatalf("Expected valid JSON, got %v:\n%s", err, output)
+		}
+		if envelope.SchemaVersion == nil || *envelope.SchemaVersion != JSONSchemaVersion {
+			t.Errorf("Expected schema_version %d, got %v", JSONSchemaVersion, envelope.SchemaVersion)
+		}
+
+		bare, _ := FormatTicketsJSON(tickets, fields)
+		var bareTickets []map[string]string
+		if err := json.Unmarshal([]byte(bare), &bareTickets); err != nil {
+			t.Fatalf("Expected valid bare JSON, got %v", err)
+		}
+		if len(envelope.Tickets) != len(bareTickets) {
+			t.Fatalf("Expected %d tickets, got %d", len(bareTickets), len(envelope.Tickets))
+		}
+		for i := range bareTickets {
+			for key, value := range bareTickets[i] {
+				if envelope.Tickets[i][key] != value {
+					t.Errorf("Expected ticket %d %s %q, got %q", i, key, value, envelope.Tickets[i][key])
+				}
+			}
+		}
+	})
+
+	t.Run("Given no tickets When formatting the envelope Then tickets is an empty array", func(t *testing.T) {
+		output, err := FormatTicketsJSONEnvelope(nil, fields)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		var envelope map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(output), &envelope); err != nil {
+			t.Fatalf("Expected valid JSON, got %v:\n%s", err, output)
+		}
+		if string(envelope["tickets"]) != "[]" {
+			t.Errorf("Expected an empty tickets array, got %s", envelope["tickets"])
+		}
+	})
+}
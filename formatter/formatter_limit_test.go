@@ -0,0 +1,46 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestFormatTicketsLimitedHeader tests the header when only part of the list is shown
+//
+// Acceptance Criteria:
+// - With a total above the number shown, the header reads "Found N of M ticket(s)"
+// - With no total, or a total equal to the number shown, the usual header is kept
+func TestFormatTicketsLimitedHeader(t *testing.T) {
+	tickets := []models.Ticket{
+		{ID: "TICKET-001", Name: "First"},
+		{ID: "TICKET-002", Name: "Second"},
+	}
+
+	t.Run("Given a total above the shown count When formatting Then the header reports N of M", func(t *testing.T) {
+		output := FormatTicketsWithOptions(tickets, Options{Total: 150})
+
+		if !strings.HasPrefix(output, "Found 2 of 150 ticket(s) assigned to you:\n\n") {
+			t.Errorf("Expected an N of M header, got:\n%s", output)
+		}
+	})
+
+	t.Run("Given a total above the shown count When formatting verbosely Then the header reports N of M", func(t *testing.T) {
+		output := FormatTicketsWithOptions(tickets, Options{Verbose: true, Total: 3})
+
+		if !strings.HasPrefix(output, "Found 2 of 3 ticket(s) assigned to you:") {
+			t.Errorf("Expected an N of M header, got:\n%s", output)
+		}
+	})
+
+	t.Run("Given no total or an equal total When formatting Then the usual header is kept", func(t *testing.T) {
+		for _, total := range []int{0, 2} {
+			output := FormatTicketsWithOptions(tickets, Options{Total: total})
+
+			if !strings.HasPrefix(output, "Found 2 ticket(s) assigned to you:") {
+				t.Errorf("Expected the usual header for total %d, got:\n%s", total, output)
+			}
+		}
+	})
+}
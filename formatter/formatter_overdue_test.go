@@ -0,0 +1,48 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestOverdueMarker tests flagging past due dates in verbose output
+//
+// Acceptance Criteria:
+// - A due date before today gets "(OVERDUE)" appended to the Due line, after the relative phrase
+// - A ticket due later today is not overdue
+// - A ticket without a due date has no Due line and no marker
+func TestOverdueMarker(t *testing.T) {
+	now := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	render := func(due time.Time) string {
+		tickets := []models.Ticket{{ID: "T1", Name: "Ticket", DueDate: due}}
+		return FormatTicketsWithOptions(tickets, Options{Verbose: true, Now: now})
+	}
+
+	t.Run("Given a due date yesterday When formatting Then the Due line is marked overdue", func(t *testing.T) {
+		output := render(time.Date(2025, 12, 31, 23, 0, 0, 0, time.UTC))
+
+		if !strings.Contains(output, "  Due: 2025-12-31 (1 day ago) (OVERDUE)\n") {
+			t.Errorf("Expected overdue marker, got:\n%s", output)
+		}
+	})
+
+	t.Run("Given a due date earlier today When formatting Then it is not overdue", func(t *testing.T) {
+		output := render(time.Date(2026, 1, 1, 0, 30, 0, 0, time.UTC))
+
+		if !strings.Contains(output, "  Due: 2026-01-01 (today)\n") || strings.Contains(output, "OVERDUE") {
+			t.Errorf("Expected no overdue marker, got:\n%s", output)
+		}
+	})
+
+	t.Run("Given no due date When formatting Then there is no Due line", func(t *testing.T) {
+		output := render(time.Time{})
+
+		if strings.Contains(output, "Due:") || strings.Contains(output, "OVERDUE") {
+			t.Errorf("Expected no Due line, got:\n%s", output)
+		}
+	})
+}
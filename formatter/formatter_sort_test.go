@@ -0,0 +1,136 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestSortTicketsNullsPlacement tests --sort-nulls combined with the sort direction
+//
+// Acceptance Criteria:
+// - By default, tickets without a value for the key sort last in both directions
+// - With nulls first, they sort first in both directions
+// - Tickets with values follow the requested direction
+// - Equal keys keep their input order (stable sort)
+func TestSortTicketsNullsPlacement(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	newTickets := func() []models.Ticket {
+		return []models.Ticket{
+			{ID: "A", DueDate: day(3)},
+			{ID: "B"},
+			{ID: "C", DueDate: day(1)},
+			{ID: "D"},
+			{ID: "E", DueDate: day(2)},
+		}
+	}
+
+	ids := func(tickets []models.Ticket) string {
+		var result []string
+		for _, ticket := range tickets {
+			result = append(result, ticket.ID)
+		}
+		return strings.Join(result, ",")
+	}
+
+	tests := []struct {
+		name       string
+		descending bool
+		nulls      string
+		expected   string
+	}{
+		{"Given ascending and nulls last When sorting by due Then undated tickets are last", false, NullsLast, "C,E,A,B,D"},
+		{"Given descending and nulls last When sorting by due Then undated tickets are still last", true, NullsLast, "A,E,C,B,D"},
+		{"Given ascending and nulls first When sorting by due Then undated tickets are first", false, NullsFirst, "B,D,C,E,A"},
+		{"Given descending and nulls first When sorting by due Then undated tickets are still first", true, NullsFirst, "B,D,A,E,C"},
+		{"Given no nulls placement When sorting by due Then undated tickets default to last", false, "", "C,E,A,B,D"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tickets := newTickets()
+			SortTicketsWithNulls(tickets, SortByDue, tt.descending, tt.nulls)
+
+			if got := ids(tickets); got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+
+	t.Run("Given SortTickets When sorting Then nulls are placed last", func(t *testing.T) {
+		tickets := newTickets()
+		SortTickets(tickets, SortByDue, true)
+
+		if got := ids(tickets); got != "A,E,C,B,D" {
+			t.Errorf("Expected A,E,C,B,D, got %s", got)
+		}
+	})
+
+	t.Run("Given an unknown sort key or nulls placement When validating Then an error is returned", func(t *testing.T) {
+		if err := ValidateSortKey("priority"); err == nil {
+			t.Error("Expected error for unknown sort key")
+		}
+		if err := ValidateNullsPlacement("middle"); err == nil {
+			t.Error("Expected error for unknown nulls placement")
+		}
+		if err := ValidateNullsPlacement(NullsFirst); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	})
+}
+
+// TestSortTicketsByKey tests each --sort key
+//
+// Acceptance Criteria:
+// - due, created, and updated sort by date, with zero dates last in both directions
+// - name sorts case-insensitively and id sorts lexically
+// - Tickets with equal keys keep their input order
+func TestSortTicketsByKey(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	tickets := []models.Ticket{
+		{ID: "T3", Name: "banana", CreatedAt: day(2), UpdatedAt: day(5)},
+		{ID: "T1", Name: "Apple", UpdatedAt: day(4)},
+		{ID: "T4", Name: "cherry", CreatedAt: day(1), UpdatedAt: day(4)},
+		{ID: "T2", Name: "apple", CreatedAt: day(2), UpdatedAt: day(6)},
+	}
+
+	ids := func(tickets []models.Ticket) string {
+		var result []string
+		for _, ticket := range tickets {
+			result = append(result, ticket.ID)
+		}
+		return strings.Join(result, ",")
+	}
+
+	tests := []struct {
+		key        string
+		descending bool
+		expected   string
+	}{
+		{SortByCreated, false, "T4,T3,T2,T1"},
+		{SortByCreated, true, "T3,T2,T4,T1"},
+		{SortByUpdated, false, "T1,T4,T3,T2"},
+		{SortByName, false, "T1,T2,T3,T4"},
+		{SortByName, true, "T4,T3,T1,T2"},
+		{SortByID, false, "T1,T2,T3,T4"},
+	}
+
+	for _, tt := range tests {
+		direction := "ascending"
+		if tt.descending {
+			direction = "descending"
+		}
+		t.Run("Given tickets When sorting by "+tt.key+" "+direction+" Then they are ordered by that key", func(t *testing.T) {
+			sorted := append([]models.Ticket{}, tickets...)
+			SortTickets(sorted, tt.key, tt.descending)
+
+			if got := ids(sorted); got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
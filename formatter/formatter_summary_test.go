@@ -0,0 +1,87 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestFormatBinSummary tests per-bin counts with due-soon highlighting
+//
+// User Story:
+// As a user, I want `fb summary` to show how many tickets in each bin are due soon,
+// so that I get an at-a-glance urgency read per column.
+//
+// Acceptance Criteria:
+// - Each bin shows its ticket count
+// - Bins with tickets due within the window show "(N due soon)"
+// - Overdue tickets and tickets without due dates are not counted as due soon
+// - The due-soon window is configurable
+func TestFormatBinSummary(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tickets := []models.Ticket{
+		{ID: "1", BinName: "In Progress", DueDate: now.Add(2 * time.Hour)},
+		{ID: "2", BinName: "In Progress", DueDate: now.Add(47 * time.Hour)},
+		{ID: "3", BinName: "In Progress", DueDate: now.Add(72 * time.Hour)},
+		{ID: "4", BinName: "In Progress", DueDate: now.Add(-24 * time.Hour)},
+		{ID: "5", BinName: "In Progress"},
+		{ID: "6", BinName: "Done", DueDate: now.Add(-2 * time.Hour)},
+		{ID: "7", BinName: "Done"},
+		{ID: "8", BinName: "Backlog", DueDate: now.Add(30 * time.Hour)},
+	}
+
+	t.Run("Given a mix of due dates per bin When summarizing Then each bin shows its count and due-soon count", func(t *testing.T) {
+		output := FormatBinSummary(tickets, now, DefaultDueSoonWindow)
+
+		if !strings.Contains(output, "Summary of 8 ticket(s) by bin:") {
+			t.Errorf("Expected header with total count, got:\n%s", output)
+		}
+		if !strings.Contains(output, "In Progress: 5 (2 due soon)") {
+			t.Errorf("Expected 'In Progress: 5 (2 due soon)', got:\n%s", output)
+		}
+		if !strings.Contains(output, "Backlog: 1 (1 due soon)") {
+			t.Errorf("Expected 'Backlog: 1 (1 due soon)', got:\n%s", output)
+		}
+	})
+
+	t.Run("Given a bin with no tickets due soon When summarizing Then no due-soon note is shown", func(t *testing.T) {
+		output := FormatBinSummary(tickets, now, DefaultDueSoonWindow)
+
+		if !strings.Contains(output, "Done: 2\n") {
+			t.Errorf("Expected 'Done: 2' without due-soon note, got:\n%s", output)
+		}
+	})
+
+	t.Run("Given a narrower window When summarizing Then fewer tickets are due soon", func(t *testing.T) {
+		output := FormatBinSummary(tickets, now, 24*time.Hour)
+
+		if !strings.Contains(output, "In Progress: 5 (1 due soon)") {
+			t.Errorf("Expected 'In Progress: 5 (1 due soon)', got:\n%s", output)
+		}
+		if !strings.Contains(output, "Backlog: 1\n") {
+			t.Errorf("Expected 'Backlog: 1' without due-soon note, got:\n%s", output)
+		}
+	})
+
+	t.Run("Given several bins When summarizing Then bins are listed alphabetically", func(t *testing.T) {
+		output := FormatBinSummary(tickets, now, DefaultDueSoonWindow)
+
+		backlog := strings.Index(output, "Backlog")
+		done := strings.Index(output, "Done")
+		inProgress := strings.Index(output, "In Progress")
+		if !(backlog < done && done < inProgress) {
+			t.Errorf("Expected bins in alphabetical order, got:\n%s", output)
+		}
+	})
+
+	t.Run("Given no tickets When summarizing Then show the empty message", func(t *testing.T) {
+		output := FormatBinSummary([]models.Ticket{}, now, DefaultDueSoonWindow)
+
+		if output != noTicketsMessage {
+			t.Errorf("Expected %q, got %q", noTicketsMessage, output)
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestFormatTicketsTable tests the aligned table output used by fb --table
+//
+// Acceptance Criteria:
+// - A header row names the ID, NAME, BIN, and DUE columns
+// - Every column starts at the same position on every row
+// - Names longer than the name column are elided with "…"
+// - An empty list prints the usual "No tickets" message
+// - Output contains no color escape sequences
+func TestFormatTicketsTable(t *testing.T) {
+	tickets := []models.Ticket{
+		{ID: "A1", Name: "Short", BinName: "Doing", DueDate: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: "LONGER-ID-2", Name: strings.Repeat("x", tableNameWidth+10), BinName: "In Review"},
+	}
+
+	t.Run("Given tickets with different widths When formatting a table Then columns align across rows", func(t *testing.T) {
+		output := FormatTicketsTable(tickets)
+		lines := strings.Split(strings.TrimRight(output, "\n"), "\n")
+
+		if len(lines) != 3 {
+			t.Fatalf("Expected header and 2 rows, got %d lines:\n%s", len(lines), output)
+		}
+		if !strings.HasPrefix(lines[0], "ID") || !strings.Contains(lines[0], "NAME") {
+			t.Errorf("Expected header row, got %q", lines[0])
+		}
+		for _, column := range []string{"NAME", "BIN"} {
+			want := runeIndex(lines[0], column)
+			for _, row := range lines[1:] {
+				fields := strings.Fields(row)
+				value := fields[1]
+				if column == "BIN" {
+					value = fields[2]
+				}
+				if got := runeIndex(row, value); got != want {
+					t.Errorf("Expected %s column at %d, got %d in %q", column, want, got, row)
+				}
+			}
+		}
+		if !strings.Contains(lines[1], "2026-03-01") {
+			t.Errorf("Expected due date in first row, got %q", lines[1])
+		}
+		if strings.Contains(output, "\x1b[") {
+			t.Errorf("Expected no color codes, got %q", output)
+		}
+	})
+
+	t.Run("Given a long name When formatting a table Then it is elided to the column width", func(t *testing.T) {
+		output := FormatTicketsTable(tickets)
+
+		elided := strings.Repeat("x", tableNameWidth-1) + "…"
+		if !strings.Contains(output, elided) {
+			t.Errorf("Expected elided name %q, got:\n%s", elided, output)
+		}
+		if strings.Contains(output, strings.Repeat("x", tableNameWidth)) {
+			t.Errorf("Expected name cut to %d characters, got:\n%s", tableNameWidth, output)
+		}
+	})
+
+	t.Run("Given no tickets When formatting a table Then the no tickets message is shown", func(t *testing.T) {
+		if output := FormatTicketsTable(nil); output != noTicketsMessage {
+			t.Errorf("Expected %q, got %q", noTicketsMessage, output)
+		}
+	})
+}
+
+// runeIndex returns the character position of substr in s, or -1
+func runeIndex(s, substr string) int {
+	i := strings.Index(s, substr)
+	if i < 0 {
+		return -1
+	}
+	return utf8.RuneCountInString(s[:i])
+}
+
+// TestFormatTicketsTableFields tests the table output with a --fields selection
+//
+// Acceptance Criteria:
+// - Only the selected columns are shown, in the given order
+// - Multi-line descriptions are flattened onto the row
+func TestFormatTicketsTableFields(t *testing.T) {
+	tickets := []models.Ticket{
+		{ID: "A1", Name: "Short", BinName: "Doing", Description: "first\nsecond", DueDate: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	t.Run("Given selected fields When formatting a table Then only those columns appear in order", func(t *testing.T) {
+		output := FormatTicketsTableFields(tickets, []string{FieldDue, FieldID})
+		lines := strings.Split(strings.TrimRight(output, "\n"), "\n")
+
+		if got := strings.Fields(lines[0]); strings.Join(got, ",") != "DUE,ID" {
+			t.Errorf("Expected header DUE,ID, got %q", lines[0])
+		}
+		if got := strings.Fields(lines[1]); strings.Join(got, ",") != "2026-03-01,A1" {
+			t.Errorf("Expected row 2026-03-01,A1, got %q", lines[1])
+		}
+		if strings.Contains(output, "Short") || strings.Contains(output, "Doing") {
+			t.Errorf("Expected unselected columns to be left out, got:\n%s", output)
+		}
+	})
+
+	t.Run("Given a multi-line description When formatting a table Then it stays on one row", func(t *testing.T) {
+		output := FormatTicketsTableFields(tickets, []string{FieldID, FieldDescription})
+
+		if !strings.Contains(output, "first second") {
+			t.Errorf("Expected flattened description, got:\n%s", output)
+		}
+		if lines := strings.Split(strings.TrimRight(output, "\n"), "\n"); len(lines) != 2 {
+			t.Errorf("Expected header and 1 row, got %d lines:\n%s", len(lines), output)
+		}
+	})
+}
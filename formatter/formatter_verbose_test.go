@@ -1,6 +1,7 @@
 package formatter
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -361,3 +362,44 @@ func TestStory2_1_VerboseEmptyListHandling(t *testing.T) {
 		t.Errorf("Verbose mode should show '%s' for empty list, got: %s", expectedMessage, output)
 	}
 }
+
+// TestVerboseDescriptionNotTruncated tests that verbose output keeps the whole description
+//
+// Acceptance Criteria:
+// - A 1000-character description appears in full in verbose output, without "..."
+// - Minimal output with the description field still caps it at 200 characters
+func TestVerboseDescriptionNotTruncated(t *testing.T) {
+	// 200 four-character words: 999 characters with separators, plus a closing "!"
+	words := make([]string, 200)
+	for i := range words {
+		words[i] = fmt.Sprintf("w%03d", i)
+	}
+	description := strings.Join(words, " ") + "!"
+	ticket := models.Ticket{ID: "TICKET-001", Name: "Long description", Description: description}
+
+	t.Run("Given a 1000-character description When formatting verbosely Then all of it is shown", func(t *testing.T) {
+		output := FormatTickets([]models.Ticket{ticket})
+
+		var shown []string
+		for _, line := range strings.Split(output, "\n") {
+			line = strings.TrimPrefix(strings.TrimSpace(line), "Description: ")
+			if strings.HasPrefix(line, "w") {
+				shown = append(shown, line)
+			}
+		}
+		if strings.Join(shown, " ") != description {
+			t.Errorf("Expected the full description, got:\n%s", output)
+		}
+		if strings.Contains(output, "...") {
+			t.Errorf("Expected no truncation ellipsis, got:\n%s", output)
+		}
+	})
+
+	t.Run("Given a 1000-character description When showing the description field Then it is capped", func(t *testing.T) {
+		output := FormatTicketsWithOptions([]models.Ticket{ticket}, Options{Fields: []string{FieldDescription}})
+
+		if !strings.Contains(output, description[:maxDescriptionLength]+"...") || strings.Contains(output, description[maxDescriptionLength:]) {
+			t.Errorf("Expected the description capped at %d characters, got:\n%s", maxDescriptionLength, output)
+		}
+	})
+}
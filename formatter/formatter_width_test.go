@@ -0,0 +1,65 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestDescriptionWrapWidth tests wrapping verbose descriptions to the configured width
+//
+// Acceptance Criteria:
+// - Without a width, descriptions wrap at 80 columns
+// - A wider width keeps a description on fewer lines, none longer than the width
+// - A narrower width wraps to more lines, none longer than the width
+func TestDescriptionWrapWidth(t *testing.T) {
+	ticket := models.Ticket{
+		ID:          "TICKET-001",
+		Name:        "Wrap me",
+		Description: strings.Repeat("word ", 36),
+	}
+
+	descriptionLines := func(output string) []string {
+		var lines []string
+		inDescription := false
+		for _, line := range strings.Split(strings.TrimRight(output, "\n"), "\n") {
+			if strings.HasPrefix(line, fieldIndent+"Description: ") {
+				inDescription = true
+			}
+			if inDescription {
+				lines = append(lines, line)
+			}
+		}
+		return lines
+	}
+
+	widths := []struct {
+		name      string
+		width     int
+		wantLines int
+	}{
+		{"Given no width When formatting Then wrap at 80 columns", 0, 3},
+		{"Given a 160-column width When formatting Then use fewer lines", 160, 2},
+		{"Given a 40-column width When formatting Then use more lines", 40, 8},
+	}
+
+	for _, tc := range widths {
+		t.Run(tc.name, func(t *testing.T) {
+			lines := descriptionLines(FormatTicketsWithOptions([]models.Ticket{ticket}, Options{Verbose: true, Width: tc.width}))
+
+			if len(lines) != tc.wantLines {
+				t.Errorf("Expected %d description lines, got %d:\n%s", tc.wantLines, len(lines), strings.Join(lines, "\n"))
+			}
+			limit := tc.width
+			if limit == 0 {
+				limit = maxLineWidth
+			}
+			for _, line := range lines {
+				if len(line) > limit {
+					t.Errorf("Line longer than %d columns: %q", limit, line)
+				}
+			}
+		})
+	}
+}
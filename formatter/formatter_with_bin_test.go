@@ -0,0 +1,43 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestFormatTicketsWithBin tests the --with-bin minimal line format
+//
+// Acceptance Criteria:
+// - Minimal lines read "[ID] Name (Bin)" with the flag on
+// - A ticket without a bin keeps "[ID] Name" with no trailing parens
+// - The header line is unchanged and no verbose details are added
+func TestFormatTicketsWithBin(t *testing.T) {
+	tickets := []models.Ticket{
+		{ID: "T1", Name: "First", BinName: "Doing"},
+		{ID: "T2", Name: "Second"},
+	}
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Given tickets with and without bins When formatting with bins Then only binned lines get parens", func(t *testing.T) {
+		output := FormatTicketsWithOptions(tickets, Options{WithBin: true, Now: now})
+		want := "Found 2 ticket(s) assigned to you:\n\n[T1] First (Doing)\n[T2] Second\n"
+
+		if output != want {
+			t.Errorf("Expected:\n%q\ngot:\n%q", want, output)
+		}
+		if strings.Contains(output, "Status:") {
+			t.Errorf("Expected no verbose details, got:\n%s", output)
+		}
+	})
+
+	t.Run("Given the flag off When formatting Then lines are the strict minimal format", func(t *testing.T) {
+		output := FormatTicketsWithOptions(tickets, Options{Now: now})
+
+		if strings.Contains(output, "(Doing)") {
+			t.Errorf("Expected no bin without WithBin, got:\n%s", output)
+		}
+	})
+}
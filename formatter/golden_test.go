@@ -0,0 +1,91 @@
+package formatter
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// update regenerates golden files instead of comparing against them:
+//
+//	go test ./formatter -run Golden -update
+var update = flag.Bool("update", false, "update golden files in testdata/")
+
+// goldenNow is the fixed reference time used for golden renders
+var goldenNow = time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+// goldenTickets is a representative ticket set covering dates, missing fields,
+// long and multi-line descriptions, and characters that need escaping
+func goldenTickets() []models.Ticket {
+	return []models.Ticket{
+		{
+			ID:          "TICKET-001",
+			Name:        "Fix login bug",
+			BinName:     "In Progress",
+			Description: "Users cannot log in with SSO after the last release. Investigate the token refresh flow and add a regression test covering expired sessions.",
+			CreatedAt:   time.Date(2026, 2, 1, 9, 30, 0, 0, time.UTC),
+			UpdatedAt:   time.Date(2026, 3, 9, 16, 0, 0, 0, time.UTC),
+			DueDate:     time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:          "TICKET-002",
+			Name:        "Write \"getting started\" docs, part 1",
+			BinID:       "binBacklog",
+			Description: "Line one\nLine two",
+			CreatedAt:   time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:      "TICKET-003",
+			Name:    "Triage incoming bugs",
+			BinName: "To Do",
+		},
+	}
+}
+
+// assertGolden compares output with testdata/<name>.golden, or rewrites the file with -update
+func assertGolden(t *testing.T, name, output string) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.WriteFile(path, []byte(output), 0644); err != nil {
+			t.Fatalf("Failed to update golden file %s: %v", path, err)
+		}
+		return
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read golden file %s (run with -update to create it): %v", path, err)
+	}
+	if output != string(expected) {
+		t.Errorf("Output does not match %s (run with -update to accept)\n--- expected ---\n%s\n--- got ---\n%s", path, expected, output)
+	}
+}
+
+// TestGoldenRenders tests the public rendering entry points against golden files
+//
+// Acceptance Criteria:
+// - Minimal, verbose, and JSON renders of a representative ticket set match testdata/
+// - Renders are deterministic: a fixed clock and no color theme
+func TestGoldenRenders(t *testing.T) {
+	t.Run("Given the golden ticket set When rendering minimal output Then it matches the golden file", func(t *testing.T) {
+		assertGolden(t, "minimal", FormatTicketsWithOptions(goldenTickets(), Options{Now: goldenNow}))
+	})
+
+	t.Run("Given the golden ticket set When rendering verbose output Then it matches the golden file", func(t *testing.T) {
+		assertGolden(t, "verbose", FormatTicketsWithOptions(goldenTickets(), Options{Verbose: true, Now: goldenNow}))
+	})
+
+	t.Run("Given the golden ticket set When rendering JSON Then it matches the golden file", func(t *testing.T) {
+		output, err := FormatTicketsJSON(goldenTickets(), DefaultFields)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		assertGolden(t, "json", output)
+	})
+}
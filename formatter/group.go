@@ -0,0 +1,134 @@
+package formatter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+const (
+	unassignedGroupName            = "(unassigned)"
+	noBinGroupName                 = "(no bin)"
+	assigneeGroupCountHeaderFormat = "Found %d ticket(s) across %d assignee(s):\n\n"
+)
+
+// ticketGroup is a named group of tickets in a grouped view
+type ticketGroup struct {
+	name    string
+	tickets []models.Ticket
+}
+
+// FormatTicketsGroupedByAssignee formats tickets grouped under a header per assignee.
+// assigneeNames maps user IDs to display names; IDs without a name are shown as-is.
+// A ticket assigned to several users is listed under each of them, and tickets
+// without any assignee are grouped under "(unassigned)".
+func FormatTicketsGroupedByAssignee(tickets []models.Ticket, assigneeNames map[string]string) string {
+	if len(tickets) == 0 {
+		return noTicketsMessage
+	}
+
+	groups := groupTickets(tickets, func(ticket models.Ticket) []string {
+		names := make([]string, 0, len(ticket.AssignedIDs))
+		for _, id := range ticket.AssignedIDs {
+			if name, ok := assigneeNames[id]; ok && name != "" {
+				names = append(names, name)
+				continue
+			}
+			names = append(names, id)
+		}
+		return names
+	}, unassignedGroupName)
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf(assigneeGroupCountHeaderFormat, len(tickets), countNamedGroups(groups, unassignedGroupName)))
+	writeTicketGroups(&builder, groups)
+	return builder.String()
+}
+
+// FormatTicketsGroupedByBin formats tickets grouped under a header per bin, sorted by bin
+// name, below the usual total line. Tickets without a bin are grouped under "(no bin)".
+func FormatTicketsGroupedByBin(tickets []models.Ticket) string {
+	if len(tickets) == 0 {
+		return noTicketsMessage
+	}
+
+	groups := groupTickets(tickets, func(ticket models.Ticket) []string {
+		if ticket.BinName == "" {
+			return nil
+		}
+		return []string{ticket.BinName}
+	}, noBinGroupName)
+
+	var builder strings.Builder
+	writeTicketHeader(&builder, len(tickets), 0)
+	writeTicketGroups(&builder, groups)
+	return builder.String()
+}
+
+// groupTickets groups tickets by the keys returned for each ticket, sorted by group name.
+// A ticket with several keys is placed in each of those groups. Tickets with no keys
+// are collected under fallback, which is always listed last.
+func groupTickets(tickets []models.Ticket, keys func(models.Ticket) []string, fallback string) []ticketGroup {
+	byName := map[string]*ticketGroup{}
+	var names []string
+	var fallbackGroup *ticketGroup
+
+	for _, ticket := range tickets {
+		ticketKeys := keys(ticket)
+		if len(ticketKeys) == 0 {
+			if fallbackGroup == nil {
+				fallbackGroup = &ticketGroup{name: fallback}
+			}
+			fallbackGroup.tickets = append(fallbackGroup.tickets, ticket)
+			continue
+		}
+
+		for _, key := range ticketKeys {
+			group, ok := byName[key]
+			if !ok {
+				group = &ticketGroup{name: key}
+				byName[key] = group
+				names = append(names, key)
+			}
+			group.tickets = append(group.tickets, ticket)
+		}
+	}
+
+	sort.Strings(names)
+	groups := make([]ticketGroup, 0, len(names)+1)
+	for _, name := range names {
+		groups = append(groups, *byName[name])
+	}
+	if fallbackGroup != nil {
+		groups = append(groups, *fallbackGroup)
+	}
+	return groups
+}
+
+// countNamedGroups returns the number of groups excluding the fallback group
+func countNamedGroups(groups []ticketGroup, fallback string) int {
+	count := 0
+	for _, group := range groups {
+		if group.name != fallback {
+			count++
+		}
+	}
+	return count
+}
+
+// writeTicketGroups writes each group as a "Name (count):" header followed by its tickets
+// in minimal form, with a blank line between groups
+func writeTicketGroups(builder *strings.Builder, groups []ticketGroup) {
+	for i, group := range groups {
+		if i > 0 {
+			builder.WriteString("\n")
+		}
+		writeField(builder, "%s (%d):", group.name, len(group.tickets))
+		for _, ticket := range group.tickets {
+			builder.WriteString(fieldIndent)
+			formatMinimalTicketLine(builder, ticket, Options{})
+		}
+	}
+}
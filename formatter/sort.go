@@ -0,0 +1,121 @@
+package formatter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// Sort keys accepted by --sort
+const (
+	SortByDue     = "due"
+	SortByCreated = "created"
+	SortByUpdated = "updated"
+	SortByName    = "name"
+	SortByID      = "id"
+)
+
+// Null placements accepted by --sort-nulls
+const (
+	NullsFirst = "first"
+	NullsLast  = "last"
+)
+
+var sortKeys = []string{SortByDue, SortByCreated, SortByUpdated, SortByName, SortByID}
+
+// ValidateSortKey checks that key is a supported sort key
+func ValidateSortKey(key string) error {
+	for _, known := range sortKeys {
+		if key == known {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown sort key '%s' (valid keys: %s)", key, strings.Join(sortKeys, ", "))
+}
+
+// ValidateNullsPlacement checks that placement is empty (the default, last), "first", or "last"
+func ValidateNullsPlacement(placement string) error {
+	switch placement {
+	case "", NullsFirst, NullsLast:
+		return nil
+	}
+	return fmt.Errorf("unknown --sort-nulls value '%s' (valid values: %s, %s)", placement, NullsFirst, NullsLast)
+}
+
+// SortTickets sorts tickets in place by key. Tickets with a zero value for the key
+// (no due date, empty name, ...) always sort last, whichever the direction.
+// The sort is stable, so tickets with equal keys keep their input order.
+func SortTickets(tickets []models.Ticket, key string, descending bool) {
+	SortTicketsWithNulls(tickets, key, descending, NullsLast)
+}
+
+// SortTicketsWithNulls sorts tickets in place by key, placing tickets with a zero value
+// for the key first or last. Null placement is independent of direction: with NullsFirst,
+// undated tickets lead both ascending and descending lists.
+func SortTicketsWithNulls(tickets []models.Ticket, key string, descending bool, nulls string) {
+	nullsFirst := nulls == NullsFirst
+
+	sort.SliceStable(tickets, func(i, j int) bool {
+		iNull, jNull := isNullSortKey(tickets[i], key), isNullSortKey(tickets[j], key)
+		if iNull || jNull {
+			if iNull == jNull {
+				return false
+			}
+			return iNull == nullsFirst
+		}
+
+		cmp := compareSortKey(tickets[i], tickets[j], key)
+		if descending {
+			return cmp > 0
+		}
+		return cmp < 0
+	})
+}
+
+// isNullSortKey reports whether the ticket has no value for the sort key
+func isNullSortKey(ticket models.Ticket, key string) bool {
+	switch key {
+	case SortByDue:
+		return ticket.DueDate.IsZero()
+	case SortByCreated:
+		return ticket.CreatedAt.IsZero()
+	case SortByUpdated:
+		return ticket.UpdatedAt.IsZero()
+	case SortByName:
+		return ticket.Name == ""
+	case SortByID:
+		return ticket.ID == ""
+	}
+	return false
+}
+
+// compareSortKey compares two tickets by the sort key, returning -1, 0, or 1
+func compareSortKey(a, b models.Ticket, key string) int {
+	switch key {
+	case SortByDue:
+		return compareTimes(a.DueDate, b.DueDate)
+	case SortByCreated:
+		return compareTimes(a.CreatedAt, b.CreatedAt)
+	case SortByUpdated:
+		return compareTimes(a.UpdatedAt, b.UpdatedAt)
+	case SortByName:
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	case SortByID:
+		return strings.Compare(a.ID, b.ID)
+	}
+	return 0
+}
+
+// compareTimes compares two times, returning -1, 0, or 1
+func compareTimes(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,91 @@
+package formatter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+const (
+	// DefaultDueSoonWindow is how far ahead a due date counts as "due soon" in the bin summary
+	DefaultDueSoonWindow   = 48 * time.Hour
+	binSummaryHeaderFormat = "Summary of %d ticket(s) by bin:\n\n"
+)
+
+// binSummary holds the counts shown for a single bin in the summary
+type binSummary struct {
+	name    string
+	count   int
+	dueSoon int
+}
+
+// FormatBinSummary formats a per-bin count of tickets, noting how many in each bin are due soon.
+// The now parameter is the reference time for the due-soon calculation so callers (and tests)
+// control the clock. Bins are listed alphabetically.
+func FormatBinSummary(tickets []models.Ticket, now time.Time, dueSoonWindow time.Duration) string {
+	if len(tickets) == 0 {
+		return noTicketsMessage
+	}
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf(binSummaryHeaderFormat, len(tickets)))
+
+	for _, summary := range summarizeBins(tickets, now, dueSoonWindow) {
+		formatBinSummaryLine(&builder, summary)
+	}
+
+	return builder.String()
+}
+
+// FormatBinCountsLine formats a one-line summary of ticket counts by bin,
+// e.g. "Assigned: 5 (Doing: 3, In Review: 2)"
+func FormatBinCountsLine(tickets []models.Ticket) string {
+	if len(tickets) == 0 {
+		return "Assigned: 0"
+	}
+
+	summaries := summarizeBins(tickets, time.Time{}, 0)
+	counts := make([]string, 0, len(summaries))
+	for _, summary := range summaries {
+		counts = append(counts, fmt.Sprintf("%s: %d", summary.name, summary.count))
+	}
+	return fmt.Sprintf("Assigned: %d (%s)", len(tickets), strings.Join(counts, ", "))
+}
+
+// summarizeBins counts tickets and due-soon tickets per bin, sorted by bin name
+func summarizeBins(tickets []models.Ticket, now time.Time, dueSoonWindow time.Duration) []binSummary {
+	byName := map[string]*binSummary{}
+	for _, ticket := range tickets {
+		name := ticket.Status()
+		summary, ok := byName[name]
+		if !ok {
+			summary = &binSummary{name: name}
+			byName[name] = summary
+		}
+		summary.count++
+		if ticket.IsDueWithin(now, dueSoonWindow) {
+			summary.dueSoon++
+		}
+	}
+
+	summaries := make([]binSummary, 0, len(byName))
+	for _, summary := range byName {
+		summaries = append(summaries, *summary)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].name < summaries[j].name
+	})
+	return summaries
+}
+
+// formatBinSummaryLine writes a single bin's count, with the due-soon count when non-zero
+func formatBinSummaryLine(builder *strings.Builder, summary binSummary) {
+	if summary.dueSoon > 0 {
+		writeField(builder, "  %s: %d (%d due soon)", summary.name, summary.count, summary.dueSoon)
+		return
+	}
+	writeField(builder, "  %s: %d", summary.name, summary.count)
+}
@@ -0,0 +1,62 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+const (
+	tableNameWidth = 40  // Names longer than this many characters are elided
+	tableEllipsis  = "…" // Marks an elided name
+	tableGap       = 2   // Spaces between columns
+)
+
+// TableFields is the column set, in order, shown by --table when no --fields selection is given
+var TableFields = []string{FieldID, FieldName, FieldBin, FieldDue}
+
+// FormatTicketsTable formats tickets as aligned ID, Name, Bin, and Due columns under a
+// header row, for scanning many tickets at once. Output is uncolored so it survives pipes
+// and redirects. An empty list prints the usual "No tickets" message.
+func FormatTicketsTable(tickets []models.Ticket) string {
+	return FormatTicketsTableFields(tickets, TableFields)
+}
+
+// FormatTicketsTableFields formats tickets as a table with only the selected fields, in the
+// given order. Names and descriptions are flattened to one line and elided to the name width.
+func FormatTicketsTableFields(tickets []models.Ticket, fields []string) string {
+	if len(tickets) == 0 {
+		return noTicketsMessage
+	}
+
+	headers := make([]string, len(fields))
+	for i, field := range fields {
+		headers[i] = strings.ToUpper(field)
+	}
+
+	var builder strings.Builder
+	writer := tabwriter.NewWriter(&builder, 0, 0, tableGap, ' ', 0)
+	fmt.Fprintln(writer, strings.Join(headers, "\t"))
+	for _, ticket := range tickets {
+		values := fieldValues(ticket, fields)
+		for i, field := range fields {
+			if field == FieldName || field == FieldDescription {
+				values[i] = elide(normalizeWhitespace(strings.TrimSpace(values[i])), tableNameWidth)
+			}
+		}
+		fmt.Fprintln(writer, strings.Join(values, "\t"))
+	}
+	writer.Flush()
+	return builder.String()
+}
+
+// elide shortens text to at most width characters, ending in an ellipsis when cut
+func elide(text string, width int) string {
+	runes := []rune(text)
+	if len(runes) <= width {
+		return text
+	}
+	return string(runes[:width-1]) + tableEllipsis
+}
@@ -3,25 +3,104 @@
 package cli
 
 import (
+	"context"
 	"flag"
 	"fmt"
+	"io"
 	"os"
+	"runtime"
 	"strings"
 	"time"
 
+	"github.com/Germanicus1/fb/api"
 	"github.com/Germanicus1/fb/config"
+	"github.com/Germanicus1/fb/formatter"
 	"github.com/Germanicus1/fb/internal/commands"
+	"github.com/Germanicus1/fb/internal/service"
 )
 
-// Run is the main entry point for the CLI application
+// Run is the main entry point for the CLI application.
+// Ctrl-C (SIGINT) or SIGTERM stops the running command cleanly.
 func Run(version string) error {
-	// Handle subcommands first (checkout, clear)
+	ctx, stop := notifyInterrupt()
+	defer stop()
+
+	err := runInterruptible(ctx, func() error {
+		return run(ctx, version, service.Options{})
+	})
+	reportError(os.Stderr, err)
+	return err
+}
+
+// run routes the command line to the matching subcommand or flag handler. Every ticket service
+// the command creates sends its requests with ctx and is created with svcOpts.
+func run(ctx context.Context, version string, svcOpts service.Options) error {
+	// A leading --support-bundle wraps whatever command follows it
+	if bundlePath, args, found := extractSupportBundlePath(os.Args[1:]); found {
+		if bundlePath == "" {
+			return fmt.Errorf("usage: fb %s <file.zip> [command]", supportBundleFlag)
+		}
+		os.Args = append([]string{os.Args[0]}, args...)
+		return runWithSupportBundle(bundlePath, version, func(trace io.Writer) error {
+			svcOpts.TraceOutput = trace
+			return run(ctx, version, svcOpts)
+		})
+	}
+
+	// --config and --profile apply to every command, so they are taken out before routing
+	if configPath, args, found := extractConfigPath(os.Args[1:]); found {
+		if configPath == "" {
+			return fmt.Errorf("usage: fb %s <path> [command]", configFlag)
+		}
+		config.SetConfigPath(configPath)
+		os.Args = append([]string{os.Args[0]}, args...)
+	}
+	if profile, args, found := extractProfile(os.Args[1:]); found {
+		if profile == "" {
+			return fmt.Errorf("usage: fb %s <name> [command]", profileFlag)
+		}
+		config.SetProfile(profile)
+		os.Args = append([]string{os.Args[0]}, args...)
+	}
+
+	// Handle subcommands first (version, checkout, clear, summary, comment, edit, advance, bins, boards, status, prompt, config, open, move, show, resolve-bin, resolve-board)
 	if len(os.Args) > 1 {
 		switch os.Args[1] {
+		case "version":
+			fmt.Print(formatVersion(version))
+			return nil
 		case "checkout":
-			return handleCheckoutSubcommand()
+			return handleCheckoutSubcommand(ctx, svcOpts)
 		case "clear":
 			return handleClearSubcommand()
+		case "summary":
+			return handleSummarySubcommand(ctx, svcOpts)
+		case "comment":
+			return handleCommentSubcommand(ctx, svcOpts)
+		case "edit":
+			return handleEditSubcommand(ctx, svcOpts)
+		case "advance":
+			return handleAdvanceSubcommand(ctx, svcOpts)
+		case "bins":
+			return handleListingSubcommand(ctx, svcOpts, "bins", commands.ExecuteBins)
+		case "boards":
+			return handleListingSubcommand(ctx, svcOpts, "boards", commands.ExecuteBoards)
+		case "status":
+			return handleStatusSubcommand(ctx, svcOpts)
+		case "prompt":
+			return handlePromptSubcommand()
+		case "config":
+			return handleConfigSubcommand(ctx, svcOpts)
+		case "open":
+			return handleOpenSubcommand(ctx, svcOpts)
+		case "move":
+			return handleMoveSubcommand(ctx, svcOpts)
+		case "show":
+			return handleShowSubcommand(ctx, svcOpts)
+		case "resolve-bin":
+			return handleResolveSubcommand(ctx, svcOpts, "resolve-bin", commands.ExecuteResolveBin)
+		case "resolve-board":
+			return handleResolveSubcommand(ctx, svcOpts, "resolve-board", commands.ExecuteResolveBoard)
 		}
 	}
 
@@ -36,7 +115,7 @@ func Run(version string) error {
 
 	// Handle version flag
 	if flags.ShowVersion {
-		fmt.Printf("fb version %s\n", version)
+		fmt.Print(formatVersion(version))
 		return nil
 	}
 
@@ -48,25 +127,27 @@ func Run(version string) error {
 
 	// Handle list-bins flag
 	if flags.ListBins {
-		cfg, err := loadConfiguration()
+		cfg, err := loadConfiguration(flags)
 		if err != nil {
 			return err
 		}
-		return commands.ExecuteListBins(cfg)
+		return commands.ExecuteListBins(ctx, svcOpts, cfg)
 	}
 
 	// Handle list-boards flag
 	if flags.ListBoards {
-		cfg, err := loadConfiguration()
+		cfg, err := loadConfiguration(flags)
 		if err != nil {
 			return err
 		}
-		return commands.ExecuteListBoards(cfg)
+		return commands.ExecuteListBoards(ctx, svcOpts, cfg)
 	}
 
 	// Handle quick comment flag
 	if flags.QuickComment != "" {
-		return commands.ExecuteQuick(flags.QuickComment)
+		return commands.ExecuteQuickWithConfig(ctx, svcOpts, flags.QuickComment, func() (*config.Config, error) {
+			return loadConfiguration(flags)
+		})
 	}
 
 	// Handle show status flag
@@ -75,30 +156,86 @@ func Run(version string) error {
 	}
 
 	// Handle bare arguments (quick comment without -c flag)
-	if len(flags.Args) > 0 && !flags.CommentMode && flags.BinFilter == "" && !flags.ListBins && !flags.ListBoards {
+	if len(flags.Args) > 0 && !flags.CommentMode && flags.BinFilter == "" && !flags.NoBin && !flags.ListBins && !flags.ListBoards {
 		// Join all arguments as the comment message
 		message := strings.Join(flags.Args, " ")
-		return commands.ExecuteQuick(message)
+		return commands.ExecuteQuickWithConfig(ctx, svcOpts, message, func() (*config.Config, error) {
+			return loadConfiguration(flags)
+		})
 	}
 
 	// Handle comment mode
 	if flags.CommentMode {
-		cfg, err := loadConfiguration()
+		cfg, err := loadConfiguration(flags)
 		if err != nil {
 			return err
 		}
-		return commands.ExecuteInteractive(cfg, flags.BinFilter)
+		return commands.ExecuteInteractive(ctx, svcOpts, cfg, flags.BinFilter)
 	}
 
 	// Default: run main list command
 	startTime := time.Now()
 
-	cfg, err := loadConfiguration()
+	cfg, err := loadConfiguration(flags)
+	if err != nil {
+		return err
+	}
+
+	theme, err := resolveTheme(cfg, flags)
 	if err != nil {
 		return err
 	}
 
-	if err := commands.Execute(cfg, flags.BinFilter, flags.Verbose); err != nil {
+	output, err := resolveOutput(flags)
+	if err != nil {
+		return err
+	}
+
+	fields, err := resolveFields(flags)
+	if err != nil {
+		return err
+	}
+
+	width, err := resolveWidth(flags, cfg.WrapWidth)
+	if err != nil {
+		return err
+	}
+
+	opts := commands.ListOptions{
+		BinFilter: flags.BinFilter,
+		NoBin:     flags.NoBin,
+		SelectBin: flags.SelectBin,
+		Verbose:   flags.Verbose,
+		Assignees: splitList(flags.Assignee),
+		GroupBy:   flags.GroupBy,
+		Theme:     theme,
+		NoEmoji:   flags.NoEmoji,
+		Quiet:     flags.Quiet,
+		Output:    output,
+		Table:     flags.Table,
+		ASCII:     flags.ASCII || !localeIsUTF8(os.Getenv, runtime.GOOS),
+		Fields:    fields,
+		Sort:      flags.Sort,
+		SortDesc:  flags.SortDesc,
+		SortNulls: flags.SortNulls,
+		Copy:      flags.Copy,
+		Limit:     flags.Limit,
+		Search:    flags.Search,
+		Overdue:   flags.Overdue,
+		Since:     flags.Since,
+		Count:     flags.Count,
+		Width:     width,
+		WithBin:   flags.WithBin,
+
+		EmptyMessage: flags.EmptyMessage,
+	}
+	applyDefaultView(&opts, flags, cfg.DefaultView)
+	if !flags.All {
+		opts.HiddenBins = cfg.HiddenBins
+	}
+	tracker := &service.Tracker{}
+	svcOpts.Tracker = tracker
+	if err := commands.Execute(ctx, svcOpts, cfg, opts); err != nil {
 		return err
 	}
 
@@ -106,20 +243,31 @@ func Run(version string) error {
 		totalDuration := time.Since(startTime)
 		fmt.Fprintf(os.Stderr, "\nPerformance Metrics:\n")
 		fmt.Fprintf(os.Stderr, "Total execution time: %.3fs\n", totalDuration.Seconds())
+		fmt.Fprintln(os.Stderr, formatRequestStats(tracker.RequestStats()))
 	}
 
 	return nil
 }
 
+// formatRequestStats summarizes the API requests of a run on one line, e.g.
+// "API requests: 3 calls, 10240 bytes received, 0.812s in HTTP"
+func formatRequestStats(stats api.RequestStats) string {
+	return fmt.Sprintf("API requests: %d calls, %d bytes received, %.3fs in HTTP", stats.Calls, stats.BytesReceived, stats.HTTPTime.Seconds())
+}
+
 // handleCheckoutSubcommand handles the checkout subcommand
-func handleCheckoutSubcommand() error {
+func handleCheckoutSubcommand(ctx context.Context, svcOpts service.Options) error {
 	fs := flag.NewFlagSet("checkout", flag.ExitOnError)
 	binFlag := fs.String("bin", "", "Filter tickets by bin name")
 	forceFlag := fs.Bool("force", false, "Force replace existing checkout")
-	fs.Parse(os.Args[2:])
+	latestFlag := fs.Bool("latest", false, "Check out the most recently updated ticket")
+	dryRunFlag := fs.Bool("dry-run", false, "Validate the checkout and show it without saving")
+	args := parseInterspersed(fs, os.Args[2:])
 
-	args := fs.Args()
-	return commands.ExecuteCheckout(args, *binFlag, *forceFlag)
+	if len(args) == 1 && (args[0] == "clear" || args[0] == "release") {
+		return commands.ExecuteClear()
+	}
+	return commands.ExecuteCheckout(ctx, svcOpts, args, *binFlag, *forceFlag, *latestFlag, *dryRunFlag)
 }
 
 // handleClearSubcommand handles the clear subcommand
@@ -127,11 +275,305 @@ func handleClearSubcommand() error {
 	return commands.ExecuteClear()
 }
 
-// loadConfiguration loads and validates the application configuration
-func loadConfiguration() (*config.Config, error) {
+// handleSummarySubcommand handles the summary subcommand
+func handleSummarySubcommand(ctx context.Context, svcOpts service.Options) error {
+	fs := flag.NewFlagSet("summary", flag.ExitOnError)
+	dueSoonFlag := fs.Duration("due-soon", 0, "Window for counting tickets as due soon (e.g. 24h)")
+	fs.Parse(os.Args[2:])
+
+	cfg, err := loadConfiguration(nil)
+	if err != nil {
+		return err
+	}
+	return commands.ExecuteSummary(ctx, svcOpts, cfg, *dueSoonFlag)
+}
+
+// handleCommentSubcommand handles the comment subcommand. Words after the ticket ID
+// are joined into the message, so it does not have to be quoted.
+func handleCommentSubcommand(ctx context.Context, svcOpts service.Options) error {
+	fs := flag.NewFlagSet("comment", flag.ExitOnError)
+	fs.Parse(os.Args[2:])
+
+	args := fs.Args()
+	if len(args) < 2 {
+		return fmt.Errorf("usage: fb comment <ticket-id> \"message\" (use - to read the message from stdin)")
+	}
+	return commands.ExecuteComment(ctx, svcOpts, args[0], strings.Join(args[1:], " "))
+}
+
+// handleOpenSubcommand handles the open subcommand, which opens a ticket in the browser
+func handleOpenSubcommand(ctx context.Context, svcOpts service.Options) error {
+	fs := flag.NewFlagSet("open", flag.ExitOnError)
+	printURL := fs.Bool("print-url", false, "Only print the ticket URL without opening a browser")
+	args := parseInterspersed(fs, os.Args[2:])
+
+	if len(args) != 1 {
+		return fmt.Errorf("usage: fb open <ticket-id> [--print-url]")
+	}
+
+	cfg, err := loadConfiguration(nil)
+	if err != nil {
+		return err
+	}
+	return commands.ExecuteOpen(ctx, svcOpts, cfg, args[0], *printURL)
+}
+
+// handleShowSubcommand handles the show subcommand, which prints one assigned ticket in full
+func handleShowSubcommand(ctx context.Context, svcOpts service.Options) error {
+	fs := flag.NewFlagSet("show", flag.ExitOnError)
+	fs.Parse(os.Args[2:])
+
+	args := fs.Args()
+	if len(args) != 1 {
+		return fmt.Errorf("usage: fb show <ticket-id>")
+	}
+
+	cfg, err := loadConfiguration(nil)
+	if err != nil {
+		return err
+	}
+	return commands.ExecuteShow(ctx, svcOpts, cfg, args[0])
+}
+
+// handleMoveSubcommand handles the move subcommand, which moves a ticket to a bin and
+// optionally comments on it in the same step
+func handleMoveSubcommand(ctx context.Context, svcOpts service.Options) error {
+	fs := flag.NewFlagSet("move", flag.ExitOnError)
+	comment := fs.String("comment", "", "Also post this comment on the ticket")
+	fs.StringVar(comment, "m", "", "Also post this comment on the ticket (short flag)")
+	args := parseInterspersed(fs, os.Args[2:])
+
+	if len(args) != 2 {
+		return fmt.Errorf("usage: fb move <ticket-id> <bin-name-or-id> [-m \"comment\"]")
+	}
+
+	cfg, err := loadConfiguration(nil)
+	if err != nil {
+		return err
+	}
+	return commands.ExecuteMove(ctx, svcOpts, cfg, args[0], args[1], *comment)
+}
+
+// handleEditSubcommand handles the edit subcommand
+func handleEditSubcommand(ctx context.Context, svcOpts service.Options) error {
+	fs := flag.NewFlagSet("edit", flag.ExitOnError)
+	nameFlag := fs.String("name", "", "New ticket name")
+	descFlag := fs.String("desc", "", "New ticket description")
+	args := parseInterspersed(fs, os.Args[2:])
+
+	// Only send the fields that were explicitly provided
+	var name, description *string
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "name":
+			name = nameFlag
+		case "desc":
+			description = descFlag
+		}
+	})
+
+	ticketID := ""
+	if len(args) > 0 {
+		ticketID = args[0]
+	}
+	return commands.ExecuteEdit(ctx, svcOpts, ticketID, name, description)
+}
+
+// handleAdvanceSubcommand handles the advance subcommand
+func handleAdvanceSubcommand(ctx context.Context, svcOpts service.Options) error {
+	fs := flag.NewFlagSet("advance", flag.ExitOnError)
+	backFlag := fs.Bool("back", false, "Move to the previous bin instead of the next one")
+	fs.Parse(os.Args[2:])
+
+	return commands.ExecuteAdvance(ctx, svcOpts, *backFlag)
+}
+
+// handleStatusSubcommand handles the status subcommand (same as -o, with optional counts or JSON)
+func handleStatusSubcommand(ctx context.Context, svcOpts service.Options) error {
+	fs := flag.NewFlagSet("status", flag.ExitOnError)
+	withCounts := fs.Bool("with-counts", false, "Also show assigned ticket counts by bin (requires network)")
+	noNetwork := fs.Bool("no-network", false, "Fail immediately instead of making any network request")
+	jsonOutput := fs.Bool("json", false, "Print the checkout state as JSON")
+	fs.Parse(os.Args[2:])
+
+	if *jsonOutput {
+		if *withCounts {
+			return fmt.Errorf("--json cannot be combined with --with-counts")
+		}
+		return commands.ExecuteStatusJSON()
+	}
+
+	if !*withCounts {
+		return commands.ExecuteStatus()
+	}
+
+	cfg, err := loadConfiguration(&Flags{NoNetwork: *noNetwork})
+	if err != nil {
+		return err
+	}
+	return commands.ExecuteStatusWithCounts(ctx, svcOpts, cfg)
+}
+
+// handlePromptSubcommand handles the prompt subcommand, which reads only local state
+func handlePromptSubcommand() error {
+	fs := flag.NewFlagSet("prompt", flag.ExitOnError)
+	count := fs.Bool("count", false, "Include the cached assigned-ticket count")
+	stale := fs.Bool("stale", false, "Show how old the cached count is")
+	fs.Parse(os.Args[2:])
+
+	return commands.ExecutePrompt(commands.PromptOptions{Count: *count, Stale: *stale})
+}
+
+// handleConfigSubcommand handles config subcommands; "check" validates the config and
+// tests authentication without fetching tickets, and "set" and "get" edit and read single
+// keys in ~/.fb/config.yaml
+func handleConfigSubcommand(ctx context.Context, svcOpts service.Options) error {
+	const usage = "usage: fb config check | fb config set <key> <value> | fb config get <key>"
+	if len(os.Args) < 3 {
+		return fmt.Errorf(usage)
+	}
+
+	fs := flag.NewFlagSet("config "+os.Args[2], flag.ExitOnError)
+	fs.Parse(os.Args[3:])
+	args := fs.Args()
+
+	switch {
+	case os.Args[2] == "check" && len(args) == 0:
+		cfg, err := loadConfiguration(nil)
+		if err != nil {
+			return err
+		}
+		return commands.ExecuteConfigCheck(ctx, svcOpts, cfg)
+	case os.Args[2] == "set" && len(args) == 2:
+		return commands.ExecuteConfigSet(args[0], args[1])
+	case os.Args[2] == "get" && len(args) == 1:
+		return commands.ExecuteConfigGet(args[0])
+	}
+	return fmt.Errorf(usage)
+}
+
+// handleListingSubcommand handles subcommands such as bins and boards that take no arguments and
+// print a listing fetched from the API
+func handleListingSubcommand(ctx context.Context, svcOpts service.Options, name string, list func(context.Context, service.Options, *config.Config) error) error {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	fs.Parse(os.Args[2:])
+
+	if len(fs.Args()) > 0 {
+		return fmt.Errorf("usage: fb %s", name)
+	}
+
+	cfg, err := loadConfiguration(nil)
+	if err != nil {
+		return err
+	}
+	return list(ctx, svcOpts, cfg)
+}
+
+// handleResolveSubcommand handles resolve-bin and resolve-board, which print the single ID
+// matching a name for use in scripts
+func handleResolveSubcommand(ctx context.Context, svcOpts service.Options, name string, resolve func(context.Context, service.Options, *config.Config, string) error) error {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	fs.Parse(os.Args[2:])
+
+	args := fs.Args()
+	if len(args) != 1 {
+		return fmt.Errorf("usage: fb %s <name>", name)
+	}
+
+	cfg, err := loadConfiguration(nil)
+	if err != nil {
+		return err
+	}
+	return resolve(ctx, svcOpts, cfg, args[0])
+}
+
+// resolveTheme builds the color theme from --theme and the theme/colors config keys.
+// Without a configured theme or colors, the default theme is used only when stdout is a
+// terminal. --theme overrides the config preset, and --plain, --no-color, or a non-empty
+// NO_COLOR environment variable turn color off regardless.
+func resolveTheme(cfg *config.Config, flags *Flags) (*formatter.Theme, error) {
+	if flags.Plain || flags.NoColor || os.Getenv(noColorEnv) != "" {
+		return nil, nil
+	}
+	preset := cfg.Theme
+	if flags.Theme != "" {
+		preset = flags.Theme
+	}
+	if preset == "" && len(cfg.Colors) == 0 && !stdoutIsTerminal() {
+		return nil, nil
+	}
+	return formatter.BuildTheme(preset, cfg.Colors)
+}
+
+// validateAppearance checks the config's theme and colors against the formatter's known presets
+// and colors. It lives here rather than in config so config stays independent of the output layer.
+func validateAppearance(cfg *config.Config) error {
+	if err := formatter.ValidateThemeName(cfg.Theme); err != nil {
+		return fmt.Errorf("invalid theme in config file: %w", err)
+	}
+	if err := formatter.ValidateColors(cfg.Colors); err != nil {
+		return fmt.Errorf("invalid colors in config file: %w", err)
+	}
+	return nil
+}
+
+// resolveOutput picks the machine-readable output mode from --csv, --json, and --json-bare.
+// --json-bare implies --json.
+func resolveOutput(flags *Flags) (string, error) {
+	switch {
+	case flags.CSV && (flags.JSON || flags.JSONBare):
+		return "", fmt.Errorf("--csv and --json cannot be used together")
+	case flags.CSV:
+		return commands.OutputCSV, nil
+	case flags.JSONBare:
+		return commands.OutputJSONBare, nil
+	case flags.JSON:
+		return commands.OutputJSON, nil
+	}
+	return "", nil
+}
+
+// applyDefaultView sets the list layout from the config's default_view, already validated at
+// load time, unless a view flag was given on the command line
+func applyDefaultView(opts *commands.ListOptions, flags *Flags, view string) {
+	if flags.Minimal || flags.WithBin || flags.Verbose || flags.Table || flags.CSV || flags.JSON || flags.JSONBare || flags.Count || flags.GroupBy != "" {
+		return
+	}
+	switch view {
+	case config.ViewTable:
+		opts.Table = true
+	case config.ViewVerbose:
+		opts.Verbose = true
+	case config.ViewJSON:
+		opts.Output = commands.OutputJSON
+	}
+}
+
+// resolveFields validates --fields up front so an unknown field errors the same way in every output mode.
+// It returns nil when no fields are selected so each mode keeps its default view.
+func resolveFields(flags *Flags) ([]string, error) {
+	if strings.TrimSpace(flags.Fields) == "" {
+		return nil, nil
+	}
+	return formatter.ParseFields(flags.Fields)
+}
+
+// loadConfiguration loads and validates the application configuration and applies
+// runtime options from the parsed flags (nil for subcommands without them)
+func loadConfiguration(flags *Flags) (*config.Config, error) {
 	cfg, err := config.LoadConfig()
 	if err != nil {
 		return nil, err
 	}
+	if err := validateAppearance(cfg); err != nil {
+		return nil, err
+	}
+	if flags != nil {
+		cfg.NoPagination = flags.NoPagination
+		cfg.Debug = flags.Verbose
+		cfg.NoNetwork = flags.NoNetwork
+		cfg.RefreshPrefix = flags.RefreshPrefix
+		cfg.RefreshUser = flags.RefreshUser
+	}
 	return cfg, nil
 }
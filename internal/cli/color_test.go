@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/config"
+)
+
+// TestResolveThemeColorDetection tests when color output is turned on automatically
+//
+// Acceptance Criteria:
+// - With no theme configured, color is on only when stdout is a terminal
+// - --no-color and a non-empty NO_COLOR turn color off even on a terminal or with a theme
+// - A configured theme still applies when stdout is not a terminal
+func TestResolveThemeColorDetection(t *testing.T) {
+	originalDetector := stdoutIsTerminal
+	defer func() { stdoutIsTerminal = originalDetector }()
+
+	setTerminal := func(isTTY bool) {
+		stdoutIsTerminal = func() bool { return isTTY }
+	}
+
+	t.Run("Given a terminal and no theme When resolving Then the default theme is used", func(t *testing.T) {
+		t.Setenv(noColorEnv, "")
+		setTerminal(true)
+
+		theme, err := resolveTheme(&config.Config{}, &Flags{})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if theme == nil || theme.Bin != "cyan" || theme.ID != "bold" || theme.Overdue != "red" {
+			t.Errorf("Expected the dark theme, got %+v", theme)
+		}
+	})
+
+	t.Run("Given a pipe and no theme When resolving Then color is off", func(t *testing.T) {
+		t.Setenv(noColorEnv, "")
+		setTerminal(false)
+
+		if theme, _ := resolveTheme(&config.Config{}, &Flags{}); theme != nil {
+			t.Errorf("Expected no theme, got %+v", theme)
+		}
+	})
+
+	t.Run("Given a pipe and a configured theme When resolving Then the theme applies", func(t *testing.T) {
+		t.Setenv(noColorEnv, "")
+		setTerminal(false)
+
+		if theme, _ := resolveTheme(&config.Config{Theme: "light"}, &Flags{}); theme == nil {
+			t.Error("Expected the light theme, got none")
+		}
+	})
+
+	t.Run("Given a terminal and --no-color When resolving Then color is off", func(t *testing.T) {
+		t.Setenv(noColorEnv, "")
+		setTerminal(true)
+
+		if theme, _ := resolveTheme(&config.Config{Theme: "dark"}, &Flags{NoColor: true}); theme != nil {
+			t.Errorf("Expected no theme, got %+v", theme)
+		}
+	})
+
+	t.Run("Given a terminal and NO_COLOR set When resolving Then color is off", func(t *testing.T) {
+		t.Setenv(noColorEnv, "1")
+		setTerminal(true)
+
+		if theme, _ := resolveTheme(&config.Config{Theme: "dark"}, &Flags{}); theme != nil {
+			t.Errorf("Expected no theme, got %+v", theme)
+		}
+	})
+}
+
+// TestValidateAppearance tests validation of the theme and colors config keys
+//
+// Acceptance Criteria:
+// - Known theme presets and colors pass
+// - An unknown color is rejected with an error naming it
+// - An unknown theme preset is rejected
+func TestValidateAppearance(t *testing.T) {
+	t.Run("Given known colors When validating Then no error", func(t *testing.T) {
+		cfg := &config.Config{
+			Theme:  "light",
+			Colors: map[string]string{"id": "cyan", "bin": "yellow", "overdue": "red"},
+		}
+
+		if err := validateAppearance(cfg); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	})
+
+	t.Run("Given an invalid color When validating Then the error names the color", func(t *testing.T) {
+		cfg := &config.Config{Colors: map[string]string{"bin": "chartreuse"}}
+
+		err := validateAppearance(cfg)
+
+		if err == nil {
+			t.Fatal("Expected error for invalid color")
+		}
+		if !strings.Contains(err.Error(), "chartreuse") {
+			t.Errorf("Expected error to mention 'chartreuse', got: %v", err)
+		}
+	})
+
+	t.Run("Given an unknown theme When validating Then return an error", func(t *testing.T) {
+		cfg := &config.Config{Theme: "neon"}
+
+		if err := validateAppearance(cfg); err == nil {
+			t.Error("Expected error for unknown theme")
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/Germanicus1/fb/config"
+	"github.com/Germanicus1/fb/internal/commands"
+)
+
+// TestApplyDefaultView tests applying the config's default_view to the list options
+//
+// Acceptance Criteria:
+// - Without a view flag, default_view table, verbose, or json selects that layout
+// - Any explicit view flag overrides default_view
+// - An empty or minimal default_view keeps the one-line-per-ticket list
+func TestApplyDefaultView(t *testing.T) {
+	t.Run("Given default_view table and no view flag When applying Then the table is selected", func(t *testing.T) {
+		opts := commands.ListOptions{}
+
+		applyDefaultView(&opts, &Flags{}, config.ViewTable)
+
+		if !opts.Table {
+			t.Error("Expected table view")
+		}
+	})
+
+	t.Run("Given default_view verbose or json When applying Then that layout is selected", func(t *testing.T) {
+		verbose := commands.ListOptions{}
+		applyDefaultView(&verbose, &Flags{}, config.ViewVerbose)
+		if !verbose.Verbose {
+			t.Error("Expected verbose view")
+		}
+
+		jsonOpts := commands.ListOptions{}
+		applyDefaultView(&jsonOpts, &Flags{}, config.ViewJSON)
+		if jsonOpts.Output != commands.OutputJSON {
+			t.Errorf("Expected JSON output, got %q", jsonOpts.Output)
+		}
+	})
+
+	t.Run("Given default_view table When an explicit view flag is given Then the flag wins", func(t *testing.T) {
+		for name, flags := range map[string]*Flags{
+			"--minimal":  {Minimal: true},
+			"--verbose":  {Verbose: true},
+			"--json":     {JSON: true},
+			"--csv":      {CSV: true},
+			"--group-by": {GroupBy: "bin"},
+		} {
+			opts := commands.ListOptions{}
+
+			applyDefaultView(&opts, flags, config.ViewTable)
+
+			if opts.Table {
+				t.Errorf("Expected %s to override default_view table", name)
+			}
+		}
+	})
+
+	t.Run("Given default_view minimal When applying Then the options are unchanged", func(t *testing.T) {
+		opts := commands.ListOptions{}
+
+		applyDefaultView(&opts, &Flags{}, config.ViewMinimal)
+
+		if opts.Table || opts.Verbose || opts.Output != "" {
+			t.Errorf("Expected the minimal list, got %+v", opts)
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/Germanicus1/fb/api"
+)
+
+// reportError writes err to output as "Error: <err>", followed by errorHint's suggestion on
+// its own line when there is one. An interrupt was already reported when it happened.
+func reportError(output io.Writer, err error) {
+	if err == nil || errors.Is(err, errInterrupted) {
+		return
+	}
+	fmt.Fprintf(output, "Error: %v\n", err)
+	if hint := errorHint(err); hint != "" {
+		fmt.Fprintln(output, hint)
+	}
+}
+
+// errorHint returns a suggestion for errors the user can fix themselves, or an empty string
+func errorHint(err error) string {
+	switch {
+	case errors.Is(err, api.ErrUnauthorized):
+		return "Your auth_key appears to be invalid — check ~/.fb/config.yaml"
+	case errors.Is(err, api.ErrForbidden):
+		return "Your auth_key does not have access to this organization — check org_id in ~/.fb/config.yaml"
+	case errors.Is(err, api.ErrRateLimited):
+		return "Flow Boards is rate limiting your requests — slow down and try again in a minute"
+	}
+	return ""
+}
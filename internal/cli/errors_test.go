@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/api"
+)
+
+// TestErrorHint tests the suggestions printed for API errors the user can fix
+//
+// Acceptance Criteria:
+// - A 401, even when wrapped, suggests checking the auth_key
+// - A 403 suggests checking org_id
+// - A 429 suggests slowing down
+// - Other errors get no hint
+func TestErrorHint(t *testing.T) {
+	t.Run("Given a wrapped 401 When describing it Then suggest checking auth_key", func(t *testing.T) {
+		err := fmt.Errorf("failed to post comment: %w", &api.APIError{StatusCode: 401})
+
+		if hint := errorHint(err); !strings.Contains(hint, "auth_key appears to be invalid") {
+			t.Errorf("Expected an auth_key hint, got %q", hint)
+		}
+	})
+
+	t.Run("Given a 403 When describing it Then suggest checking org_id", func(t *testing.T) {
+		if hint := errorHint(&api.APIError{StatusCode: 403}); !strings.Contains(hint, "org_id") {
+			t.Errorf("Expected an org_id hint, got %q", hint)
+		}
+	})
+
+	t.Run("Given a 429 When describing it Then suggest slowing down", func(t *testing.T) {
+		if hint := errorHint(&api.APIError{StatusCode: 429}); !strings.Contains(hint, "slow down") {
+			t.Errorf("Expected a rate limit hint, got %q", hint)
+		}
+	})
+
+	t.Run("Given a 500 When describing it Then there is no hint", func(t *testing.T) {
+		if hint := errorHint(&api.APIError{StatusCode: 500}); hint != "" {
+			t.Errorf("Expected no hint, got %q", hint)
+		}
+	})
+}
+
+// TestReportError tests printing the error a command failed with
+//
+// Acceptance Criteria:
+// - The error is printed to stderr as "Error: <err>"
+// - A hint, when there is one, follows on its own line
+// - No error and an interrupt print nothing
+func TestReportError(t *testing.T) {
+	t.Run("Given a plain error When reporting Then it is printed with an Error prefix", func(t *testing.T) {
+		var output bytes.Buffer
+
+		reportError(&output, errors.New("unknown config key 'foo'"))
+
+		if output.String() != "Error: unknown config key 'foo'\n" {
+			t.Errorf("Unexpected output: %q", output.String())
+		}
+	})
+
+	t.Run("Given an error with a hint When reporting Then the hint follows the error", func(t *testing.T) {
+		var output bytes.Buffer
+
+		reportError(&output, fmt.Errorf("failed to get bins: %w", &api.APIError{StatusCode: 429}))
+
+		lines := strings.Split(strings.TrimSuffix(output.String(), "\n"), "\n")
+		if len(lines) != 2 || !strings.HasPrefix(lines[0], "Error: failed to get bins") || !strings.Contains(lines[1], "slow down") {
+			t.Errorf("Expected the error then the hint, got %q", output.String())
+		}
+	})
+
+	t.Run("Given no error or an interrupt When reporting Then nothing is printed", func(t *testing.T) {
+		var output bytes.Buffer
+
+		reportError(&output, nil)
+		reportError(&output, errInterrupted)
+
+		if output.Len() != 0 {
+			t.Errorf("Expected no output, got %q", output.String())
+		}
+	})
+}
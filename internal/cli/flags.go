@@ -3,20 +3,54 @@ package cli
 import (
 	"flag"
 	"os"
+	"strings"
 )
 
 // Flags represents all CLI flags
 type Flags struct {
-	ShowVersion  bool
-	ShowHelp     bool
-	BinFilter    string
-	ListBins     bool
-	ListBoards   bool
-	CommentMode  bool
-	QuickComment string
-	ShowStatus   bool
-	Verbose      bool
-	Args         []string
+	ShowVersion   bool
+	ShowHelp      bool
+	BinFilter     string
+	NoBin         bool
+	SelectBin     bool
+	ListBins      bool
+	ListBoards    bool
+	CommentMode   bool
+	QuickComment  string
+	ShowStatus    bool
+	Verbose       bool
+	Assignee      string
+	GroupBy       string
+	Theme         string
+	NoEmoji       bool
+	Plain         bool
+	NoColor       bool
+	Quiet         bool
+	CSV           bool
+	JSON          bool
+	JSONBare      bool
+	Table         bool
+	Minimal       bool
+	WithBin       bool
+	ASCII         bool
+	All           bool
+	Fields        string
+	EmptyMessage  string
+	Sort          string
+	SortDesc      bool
+	SortNulls     string
+	Copy          bool
+	Limit         int
+	Width         int
+	Search        string
+	Overdue       bool
+	Since         string
+	Count         bool
+	NoNetwork     bool
+	NoPagination  bool
+	RefreshPrefix bool
+	RefreshUser   bool
+	Args          []string
 }
 
 // parseFlags parses command line flags and returns a Flags struct
@@ -28,6 +62,7 @@ func parseFlags() (*Flags, error) {
 	fs.BoolVar(&flags.ShowVersion, "version", false, "Display version information")
 	fs.BoolVar(&flags.ShowHelp, "help", false, "Display help message")
 	fs.StringVar(&flags.BinFilter, "bin", "", "Filter tickets by bin name")
+	fs.BoolVar(&flags.NoBin, "no-bin", false, "Show only tickets with no bin assigned")
 	fs.BoolVar(&flags.ListBins, "list-bins", false, "List all available bins")
 	fs.BoolVar(&flags.ListBoards, "list-boards", false, "List all available boards")
 	fs.BoolVar(&flags.CommentMode, "comment", false, "Add a comment to a ticket")
@@ -36,11 +71,131 @@ func parseFlags() (*Flags, error) {
 	fs.BoolVar(&flags.Verbose, "verbose", false, "Enable verbose output")
 	fs.BoolVar(&flags.Verbose, "v", false, "Enable verbose output (short flag)")
 	fs.BoolVar(&flags.Verbose, "debug", false, "Enable debug output")
+	fs.StringVar(&flags.Assignee, "assignee", "", "Comma-separated emails of users whose tickets to list")
+	fs.StringVar(&flags.GroupBy, "group-by", "", "Group tickets (assignee, bin)")
+	fs.StringVar(&flags.Theme, "theme", "", "Color theme (dark, light, none)")
+	fs.BoolVar(&flags.Plain, "plain", false, "Plain, deterministic output without color")
+	fs.BoolVar(&flags.NoColor, "no-color", false, "Disable color even on a terminal")
+	fs.BoolVar(&flags.ASCII, "ascii", false, "Use ASCII markers for terminals without UTF-8 support")
+	fs.BoolVar(&flags.NoEmoji, "no-emoji", false, "Strip emoji from ticket names and descriptions")
+	fs.BoolVar(&flags.Quiet, "quiet", false, "Print only ticket lines, without the header, footer, or hints")
+	fs.BoolVar(&flags.CSV, "csv", false, "Output tickets as CSV")
+	fs.BoolVar(&flags.JSON, "json", false, "Output tickets as JSON")
+	fs.BoolVar(&flags.JSONBare, "json-bare", false, "Output tickets as a bare JSON array, without the schema_version envelope")
+	fs.BoolVar(&flags.Table, "table", false, "Show tickets as aligned ID, name, bin, and due columns")
+	fs.BoolVar(&flags.Minimal, "minimal", false, "Show one line per ticket, overriding default_view")
+	fs.BoolVar(&flags.WithBin, "with-bin", false, "Add each ticket's bin to minimal lines, e.g. [ID] Name (Bin)")
+	fs.StringVar(&flags.EmptyMessage, "empty-message", "", "Message to show instead of \"No tickets assigned to you.\"")
+	fs.StringVar(&flags.Sort, "sort", "", "Sort tickets by due, created, updated, name, or id")
+	fs.BoolVar(&flags.SortDesc, "sort-desc", false, "Sort in descending order")
+	fs.StringVar(&flags.SortNulls, "sort-nulls", "", "Place tickets without a sort value first or last (default last)")
+	fs.BoolVar(&flags.NoNetwork, "no-network", false, "Fail immediately instead of making any network request")
+	fs.BoolVar(&flags.Copy, "copy", false, "Also copy the output to the clipboard")
+	fs.StringVar(&flags.Search, "search", "", "Show only tickets whose name or description contains this text")
+	fs.StringVar(&flags.Since, "since", "", "Show only tickets updated since an age (24h, 7d) or a date (2026-01-01)")
+	fs.BoolVar(&flags.All, "all", false, "Also show tickets in the hidden_bins from the config")
+	fs.BoolVar(&flags.Overdue, "overdue", false, "Show only tickets whose due date has passed")
+	fs.BoolVar(&flags.Count, "count", false, "Print only the number of tickets")
+	fs.IntVar(&flags.Limit, "limit", 0, "Show at most this many tickets (0 for all)")
+	fs.IntVar(&flags.Width, "width", 0, "Wrap output to this many columns instead of the terminal width")
+	fs.StringVar(&flags.Fields, "fields", "", "Comma-separated fields to show (id, name, bin, created, updated, due, description)")
 
-	if err := fs.Parse(os.Args[1:]); err != nil {
+	// Advanced/debug flags, intentionally left out of the help text
+	fs.BoolVar(&flags.NoPagination, "no-pagination", false, "Fetch only the first page of paginated results (debug)")
+	fs.BoolVar(&flags.RefreshPrefix, "refresh-prefix", false, "Rediscover the API endpoint instead of using the cached one")
+	fs.BoolVar(&flags.RefreshUser, "refresh-user", false, "Look up your user ID instead of using the cached one")
+
+	args, selectBin := extractBareBinFlag(os.Args[1:])
+	flags.SelectBin = selectBin
+
+	if err := fs.Parse(args); err != nil {
 		return nil, err
 	}
 
+	// An explicitly empty --bin "" selects tickets without a bin, the same as --no-bin
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == "bin" && flags.BinFilter == "" {
+			flags.NoBin = true
+		}
+	})
+
 	flags.Args = fs.Args()
 	return flags, nil
 }
+
+// configFlag selects an explicit config file for any command, e.g. fb --config alt.yaml status
+const configFlag = "--config"
+
+// profileFlag selects a named profile from the config for any command, e.g. fb --profile work
+const profileFlag = "--profile"
+
+// extractConfigPath removes --config <path> (or --config=<path>) from anywhere in args and
+// returns the path, the remaining args, and whether the flag was given
+func extractConfigPath(args []string) (string, []string, bool) {
+	return extractGlobalFlag(args, configFlag)
+}
+
+// extractProfile removes --profile <name> (or --profile=<name>) from anywhere in args and
+// returns the name, the remaining args, and whether the flag was given
+func extractProfile(args []string) (string, []string, bool) {
+	return extractGlobalFlag(args, profileFlag)
+}
+
+// extractGlobalFlag removes a flag that applies to every command, with its value, from
+// anywhere in args. The value is empty when the flag is the last argument.
+func extractGlobalFlag(args []string, name string) (string, []string, bool) {
+	for i, arg := range args {
+		if value, ok := strings.CutPrefix(arg, name+"="); ok {
+			return value, append(append([]string{}, args[:i]...), args[i+1:]...), true
+		}
+		if arg != name {
+			continue
+		}
+		if i == len(args)-1 {
+			return "", args[:i], true
+		}
+		return args[i+1], append(append([]string{}, args[:i]...), args[i+2:]...), true
+	}
+	return "", args, false
+}
+
+// extractBareBinFlag removes a --bin given without a value (last argument, or followed
+// by another flag) so it can trigger interactive bin selection instead of a parse error
+func extractBareBinFlag(args []string) ([]string, bool) {
+	for i, arg := range args {
+		if arg != "--bin" && arg != "-bin" {
+			continue
+		}
+		if i == len(args)-1 || strings.HasPrefix(args[i+1], "-") {
+			remaining := append(append([]string{}, args[:i]...), args[i+1:]...)
+			return remaining, true
+		}
+	}
+	return args, false
+}
+
+// parseInterspersed parses a subcommand's flags when they appear before or after
+// its positional arguments (e.g. "fb edit ID --name x"), returning the positional arguments
+func parseInterspersed(fs *flag.FlagSet, args []string) []string {
+	var positional []string
+	for {
+		fs.Parse(args)
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// splitList splits a comma-separated flag value, trimming whitespace and dropping empty entries
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestExtractConfigPath tests taking --config out of the command line before routing
+//
+// Acceptance Criteria:
+// - --config <path> and --config=<path> are removed wherever they appear
+// - The remaining args keep their order, so subcommands still route
+// - Without --config the args are unchanged
+func TestExtractConfigPath(t *testing.T) {
+	t.Run("Given --config before a subcommand When extracting Then the subcommand follows", func(t *testing.T) {
+		path, rest, found := extractConfigPath([]string{"--config", "alt.yaml", "status", "--with-counts"})
+
+		if !found || path != "alt.yaml" || strings.Join(rest, " ") != "status --with-counts" {
+			t.Errorf("Unexpected result: %q %v %v", path, rest, found)
+		}
+	})
+
+	t.Run("Given --config=path among list flags When extracting Then it is split off", func(t *testing.T) {
+		path, rest, found := extractConfigPath([]string{"--bin", "Doing", "--config=/etc/fb.yaml", "--count"})
+
+		if !found || path != "/etc/fb.yaml" || strings.Join(rest, " ") != "--bin Doing --count" {
+			t.Errorf("Unexpected result: %q %v %v", path, rest, found)
+		}
+	})
+
+	t.Run("Given --config without a path When extracting Then the path is empty", func(t *testing.T) {
+		path, _, found := extractConfigPath([]string{"status", "--config"})
+
+		if !found || path != "" {
+			t.Errorf("Expected a found flag with no path, got %q %v", path, found)
+		}
+	})
+
+	t.Run("Given no --config When extracting Then args are unchanged", func(t *testing.T) {
+		_, rest, found := extractConfigPath([]string{"checkout", "--latest"})
+
+		if found || strings.Join(rest, " ") != "checkout --latest" {
+			t.Errorf("Expected args unchanged, got %v %v", rest, found)
+		}
+	})
+}
+
+// TestExtractProfile tests taking --profile out of the command line before routing
+//
+// Acceptance Criteria:
+// - --profile <name> and --profile=<name> are removed wherever they appear
+// - Without --profile the args are unchanged
+func TestExtractProfile(t *testing.T) {
+	t.Run("Given --profile after a subcommand When extracting Then the subcommand still routes", func(t *testing.T) {
+		name, rest, found := extractProfile([]string{"status", "--profile", "work", "--json"})
+
+		if !found || name != "work" || strings.Join(rest, " ") != "status --json" {
+			t.Errorf("Unexpected result: %q %v %v", name, rest, found)
+		}
+	})
+
+	t.Run("Given --profile=name When extracting Then it is split off", func(t *testing.T) {
+		name, rest, found := extractProfile([]string{"--profile=client", "--bin", "Doing"})
+
+		if !found || name != "client" || strings.Join(rest, " ") != "--bin Doing" {
+			t.Errorf("Unexpected result: %q %v %v", name, rest, found)
+		}
+	})
+
+	t.Run("Given no --profile When extracting Then args are unchanged", func(t *testing.T) {
+		_, rest, found := extractProfile([]string{"--bin", "Doing"})
+
+		if found || strings.Join(rest, " ") != "--bin Doing" {
+			t.Errorf("Expected args unchanged, got %v %v", rest, found)
+		}
+	})
+}
@@ -17,22 +17,68 @@ Usage:
   fb                        Display all tickets assigned to you
   fb --bin "In Progress"    Display tickets in a specific bin
   fb --comment              Add a comment to a ticket (interactive)
+  fb summary                Show ticket counts per bin with tickets due soon
   fb checkout --bin "Bin"   Check out a ticket to work on
-  fb checkout TICKET-ID     Check out a specific ticket by ID
+  fb checkout TICKET-ID     Check out a specific ticket by ID or unique name substring
   fb -c "message"           Quick comment on checked-out ticket
+  fb comment ID "message"   Comment on any ticket by ID (message - reads stdin)
   fb -o                     View currently checked-out ticket
-  fb clear                  Clear checked-out ticket
-  fb --version              Display version information
+  fb status                 View currently checked-out ticket (same as -o)
+  fb show ID                Show one assigned ticket in full (ID or unique ID prefix)
+  fb open ID [--print-url]  Open a ticket in the browser (or only print its URL)
+  fb edit [ID] --name "..." Update a ticket's name and/or description (--desc)
+  fb move ID "Bin" [-m msg] Move a ticket to a bin by name or ID, optionally commenting
+  fb advance [--back]       Move the checked-out ticket to the next (or previous) bin
+  fb clear                  Release checked-out ticket (also: fb checkout clear)
+  fb prompt [--count]       Compact checkout/ticket count for shell prompts (no network)
+  fb bins                   List bin names and IDs, sorted by name
+  fb boards                 List board names, IDs, and bin counts, sorted by name
+  fb resolve-bin "Name"     Print the ID of the bin with this name (also resolve-board)
+  fb config check           Validate the config and test authentication (no tickets fetched)
+  fb config set KEY VALUE   Set auth_key, org_id, or user_email in ~/.fb/config.yaml (or --config)
+  fb config get KEY         Print a config value (auth_key shows only its last 4 characters)
+  fb version                Display version, Go runtime, and OS/arch (also --version)
   fb --help                 Display this help message
 
 Flags:
   --help                    Show this help message
   --version                 Show version information
-  --bin <id or name>        Filter tickets by bin ID or bin name
+  --bin <id or name>        Filter tickets by bin ID or bin name (comma-separate several bins)
+  --no-bin                  Show only tickets with no bin assigned (same as --bin "")
+  --bin                     Without a value, pick one or more bins from a list (interactive only)
   --comment                 Add a comment to a ticket (interactive)
   -c <message>              Quick comment on checked-out ticket
   -o                        View current checkout status
   --verbose                 Enable verbose output with performance metrics
+  --assignee <emails>       List tickets for these users (comma-separated)
+  --group-by assignee|bin   Group tickets under a header per assignee or per bin
+  --theme <name>            Color theme: dark, light, or none
+  --plain                   Plain output without color, stable for scripts and snapshots
+  --no-color                Disable color (also NO_COLOR=1); color is on by default only on a terminal
+  --no-emoji                Strip emoji from ticket names and descriptions
+  --width <n>               Wrap descriptions to n columns (default: wrap_width, terminal width, or 80)
+  --quiet                   Print only ticket lines: no header, footer, or hints
+  --sort <key>              Sort by due, created, updated, name, or id
+  --sort-desc               Sort in descending order
+  --sort-nulls first|last   Where tickets without a value for the key go (default last)
+  --search <text>           Show tickets whose name or description contains the text
+  --since <age|date>        Show tickets updated since 24h, 7d, or a date like 2026-01-01
+  --all                     Also show tickets in the hidden_bins from the config
+  --overdue                 Show only tickets whose due date is before today
+  --count                   Print only the number of matching tickets, e.g. for scripts
+  --limit <n>               Show only the first n tickets; the header still reports the total
+  --empty-message <text>    Message to show when no tickets are found
+  --no-network              Fail immediately instead of making network requests
+  --copy                    Also copy the output to the clipboard
+  --csv                     Output tickets as CSV
+  --json                    Output tickets as JSON: {"schema_version": 1, "tickets": [...]}
+  --json-bare               Output tickets as a bare JSON array, without the envelope
+  --table                   Show tickets as aligned ID, name, bin, and due columns
+  --minimal                 Show one line per ticket, overriding default_view
+  --with-bin                Add the bin to minimal lines: [ID] Name (Bin)
+  --ascii                   Mark the checked-out ticket with "<--" instead of an arrow
+  --fields <list>           Fields to show: id, name, bin, created, updated, due, description
+  --support-bundle <zip>    Run the command that follows and save a diagnostic zip (key redacted)
 
 Checkout Workflow:
   1. Check out a ticket:    fb checkout --bin "In Progress"
@@ -44,23 +90,64 @@ Checkout Workflow:
 Examples:
   fb --bin "In Progress"           Show only tickets in the "In Progress" bin
   fb --bin kX41z9DVe               Show only tickets in the bin with ID "kX41z9DVe..."
+  fb --bin "Doing,In Review"       Show tickets in either bin
   fb --comment                     Add a comment to a ticket (interactive)
   fb --comment --bin "In Progress" Add a comment to a ticket in the "In Progress" bin
+  fb --assignee a@x.com,b@x.com --group-by assignee
+                                   Show each person's tickets under their own header
+  fb --sort due --sort-nulls first Undated tickets first, then by due date
+  fb --csv --fields id,name,due    Export selected columns as CSV
+  fb --json --fields id,bin        Emit JSON objects with only the id and bin_name keys
+  fb --table --fields id,name,due  Show only the id, name, and due columns
+  fb summary                       Show per-bin counts, e.g. "In Progress: 5 (2 due soon)"
+  fb summary --due-soon 24h        Count tickets due within 24 hours as due soon
 
   fb checkout --bin "Doing"        Check out a ticket from "Doing" bin
   fb checkout yL4rjYNU5PMlu7K8B    Check out specific ticket by ID
+  fb checkout --latest             Check out the ticket you updated most recently
+  fb checkout ID --dry-run         Validate a checkout and show it without saving
   fb -c "Making progress"          Quick comment on checked-out ticket
   fb -o                            Show which ticket is checked out
+  fb status --with-counts          Show the checkout plus ticket counts by bin
+  fb status --json                 Print the checkout state as JSON
+  fb prompt --count --stale        Prompt segment, e.g. "TICKET-001 5 (2 hours ago)"
+  fb advance                       Move the checked-out ticket from To Do to In Progress
+  fb advance --back                Move it back to the bin on its left
   fb clear                         Clear the checked-out ticket
+  fb edit --name "New title"       Rename the checked-out ticket
+  fb edit yL4rjYNU5 --desc "..."   Update the description of a specific ticket
+  fb resolve-bin "In Progress"     Print the bin's ID (errors if missing or ambiguous)
+  fb resolve-board "Roadmap"       Print the board's ID (errors if missing or ambiguous)
+  fb --support-bundle out.zip status --with-counts
+                                   Save a trace, masked config, version, and output for a bug report
 
 Configuration:
   The tool reads configuration from ~/.fb/config.yaml
 
+  FB_AUTH_KEY, FB_ORG_ID, and FB_USER_EMAIL override the file (no file needed if all are set).
+  --config <path> loads that file instead, for any command.
+  --profile <name> uses the credentials of a named profile, for any command.
+
   Required configuration fields:
     auth_key:    Your Flow Boards API authentication key
     org_id:      Your organization identifier
     user_email:  Your email address for filtering tickets
 
+  Optional configuration fields:
+    due_soon_hours: Window for "due soon" counts in fb summary (default 48)
+    large_fetch_threshold: Ticket count above which an unfiltered list suggests --bin (default 500)
+    timeout_seconds: API request timeout in seconds; 0 disables it (default 30)
+    page_size:      Results requested per page of bins and boards (default 1000)
+    rate_limit_max_wait_seconds: Longest Retry-After to wait for when rate limited (default 30)
+    wrap_width:     Columns descriptions wrap to, overriding the terminal width (20-1000)
+    web_base_url:   Web app URL for fb open, e.g. https://fb.example.com/my-org
+    theme:          Color preset: dark, light, or none
+    colors:         Per-element colors, e.g. {id: cyan, bin: yellow, overdue: red}
+    default_view:   List layout without a view flag: minimal, table, verbose, or json
+    hidden_bins:    Bins left out of the list unless --all, e.g. [Done, Archived]
+    profiles:       Named credential sets, e.g. {work: {auth_key: ..., org_id: ..., user_email: ...}}
+    default_profile: Profile used when --profile is not given
+
 Example configuration file (~/.fb/config.yaml):
   auth_key: your-api-key-here
   org_id: your-org-id
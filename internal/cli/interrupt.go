@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// errInterrupted is returned when the user interrupts a running command
+var errInterrupted = errors.New("interrupted")
+
+// interruptGracePeriod is how long an interrupted command has to return after its requests are
+// cancelled. A command blocked on something the context cannot cancel, such as a prompt
+// reading stdin, is abandoned once it passes.
+var interruptGracePeriod = 2 * time.Second
+
+// runInterruptible runs command until it finishes or ctx is cancelled. Requests made through
+// the ticket services use ctx, so cancelling it aborts them; the command is then given
+// interruptGracePeriod to return before errInterrupted is returned, so the caller can exit
+// cleanly instead of being killed mid-way. State files are written atomically, so an
+// abandoned write leaves the previous state in place.
+func runInterruptible(ctx context.Context, command func() error) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- command()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+	}
+
+	fmt.Fprintln(os.Stderr, "\nInterrupted.")
+	select {
+	case <-done:
+	case <-time.After(interruptGracePeriod):
+	}
+	return errInterrupted
+}
+
+// notifyInterrupt returns a context that is cancelled on SIGINT or SIGTERM
+func notifyInterrupt() (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+}
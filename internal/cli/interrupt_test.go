@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestRunInterruptible tests clean termination when the command context is cancelled
+//
+// Acceptance Criteria:
+// - A command that finishes returns its own result
+// - Cancelling the context (as SIGINT/SIGTERM does) returns errInterrupted promptly, without panicking
+// - A command that honours the context has returned before runInterruptible does
+func TestRunInterruptible(t *testing.T) {
+	t.Run("Given a command that completes When running Then its error is returned", func(t *testing.T) {
+		expected := errors.New("command failed")
+
+		err := runInterruptible(context.Background(), func() error { return expected })
+
+		if err != expected {
+			t.Errorf("Expected %v, got %v", expected, err)
+		}
+	})
+
+	t.Run("Given a long-running command When the context is cancelled Then it terminates cleanly", func(t *testing.T) {
+		original := interruptGracePeriod
+		interruptGracePeriod = 50 * time.Millisecond
+		t.Cleanup(func() { interruptGracePeriod = original })
+
+		ctx, cancel := context.WithCancel(context.Background())
+		release := make(chan struct{})
+		defer close(release)
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			cancel()
+		}()
+
+		start := time.Now()
+		err := runInterruptible(ctx, func() error {
+			<-release
+			return nil
+		})
+
+		if !errors.Is(err, errInterrupted) {
+			t.Errorf("Expected errInterrupted, got %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("Expected prompt termination, took %s", elapsed)
+		}
+	})
+	t.Run("Given a command using the context When it is cancelled Then the command returns first", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			cancel()
+		}()
+
+		finished := false
+		err := runInterruptible(ctx, func() error {
+			<-ctx.Done()
+			time.Sleep(20 * time.Millisecond)
+			finished = true
+			return ctx.Err()
+		})
+
+		if !errors.Is(err, errInterrupted) {
+			t.Errorf("Expected errInterrupted, got %v", err)
+		}
+		if !finished {
+			t.Error("Expected the command to have returned before runInterruptible")
+		}
+	})
+}
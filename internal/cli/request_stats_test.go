@@ -0,0 +1,31 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Germanicus1/fb/api"
+)
+
+// TestFormatRequestStats tests the one-line request summary printed under --debug
+//
+// Acceptance Criteria:
+// - Calls, bytes received, and HTTP time appear on one line
+// - A run without requests reports zeros
+func TestFormatRequestStats(t *testing.T) {
+	t.Run("Given request counters When formatting Then they are summarized on one line", func(t *testing.T) {
+		stats := api.RequestStats{Calls: 3, BytesReceived: 10240, HTTPTime: 812 * time.Millisecond}
+
+		expected := "API requests: 3 calls, 10240 bytes received, 0.812s in HTTP"
+		if got := formatRequestStats(stats); got != expected {
+			t.Errorf("Expected %q, got %q", expected, got)
+		}
+	})
+
+	t.Run("Given no requests When formatting Then zeros are reported", func(t *testing.T) {
+		expected := "API requests: 0 calls, 0 bytes received, 0.000s in HTTP"
+		if got := formatRequestStats(api.RequestStats{}); got != expected {
+			t.Errorf("Expected %q, got %q", expected, got)
+		}
+	})
+}
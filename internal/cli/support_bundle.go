@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/Germanicus1/fb/config"
+)
+
+// supportBundleFlag must come before the command it wraps, e.g. fb --support-bundle out.zip status
+const supportBundleFlag = "--support-bundle"
+
+// Entries written to a support bundle, in order
+const (
+	bundleVersionEntry = "version.txt"
+	bundleConfigEntry  = "config.yaml"
+	bundleTraceEntry   = "trace.log"
+	bundleOutputEntry  = "output.txt"
+)
+
+// extractSupportBundlePath removes a leading --support-bundle <path> (or --support-bundle=<path>)
+// from args and returns the zip path, the remaining args, and whether the flag was given
+func extractSupportBundlePath(args []string) (string, []string, bool) {
+	if len(args) == 0 {
+		return "", args, false
+	}
+	if path, ok := strings.CutPrefix(args[0], supportBundleFlag+"="); ok {
+		return path, args[1:], true
+	}
+	if args[0] != supportBundleFlag {
+		return "", args, false
+	}
+	if len(args) < 2 {
+		return "", nil, true
+	}
+	return args[1], args[2:], true
+}
+
+// runWithSupportBundle runs command, which traces its API requests to the writer it is given,
+// while capturing its output, then writes a zip with the trace, the effective config, the
+// version, and the output. The auth key is masked in the config and scrubbed from every entry. The command's own
+// error is returned once the bundle is written.
+func runWithSupportBundle(path, version string, command func(trace io.Writer) error) error {
+	var trace bytes.Buffer
+	output, cmdErr := captureStdout(func() error {
+		return command(&trace)
+	})
+	if cmdErr != nil {
+		output += fmt.Sprintf("error: %v\n", cmdErr)
+	}
+
+	configText, authKey := describeEffectiveConfig()
+
+	entries := []struct{ name, content string }{
+		{bundleVersionEntry, formatVersion(version)},
+		{bundleConfigEntry, configText},
+		{bundleTraceEntry, trace.String()},
+		{bundleOutputEntry, output},
+	}
+
+	var bundle bytes.Buffer
+	zw := zip.NewWriter(&bundle)
+	for _, entry := range entries {
+		w, err := zw.Create(entry.name)
+		if err != nil {
+			return fmt.Errorf("failed to write support bundle: %w", err)
+		}
+		content := entry.content
+		if authKey != "" {
+			content = strings.ReplaceAll(content, authKey, "[REDACTED]")
+		}
+		if _, err := io.WriteString(w, content); err != nil {
+			return fmt.Errorf("failed to write support bundle: %w", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to write support bundle: %w", err)
+	}
+	if err := os.WriteFile(path, bundle.Bytes(), 0600); err != nil {
+		return fmt.Errorf("failed to write support bundle: %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "Support bundle written to %s\n", path)
+	return cmdErr
+}
+
+// captureStdout runs command with os.Stdout teed into a buffer, returning what it printed
+func captureStdout(command func() error) (string, error) {
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		return "", command()
+	}
+
+	original := os.Stdout
+	os.Stdout = writer
+
+	var captured bytes.Buffer
+	done := make(chan struct{})
+	go func() {
+		io.Copy(io.MultiWriter(original, &captured), reader)
+		close(done)
+	}()
+
+	cmdErr := command()
+
+	os.Stdout = original
+	writer.Close()
+	<-done
+	reader.Close()
+	return captured.String(), cmdErr
+}
+
+// describeEffectiveConfig returns the loaded config as YAML with the auth key masked, along
+// with the raw auth key so it can be scrubbed elsewhere. A config that fails to load is
+// described by its error instead.
+func describeEffectiveConfig() (string, string) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Sprintf("# config could not be loaded: %v\n", err), ""
+	}
+	data, err := cfg.MaskedYAML()
+	if err != nil {
+		return fmt.Sprintf("# %v\n", err), cfg.AuthKey
+	}
+	return string(data), cfg.AuthKey
+}
@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/config"
+	"github.com/Germanicus1/fb/internal/service"
+)
+
+// TestRunWithSupportBundle tests writing a support bundle around a command
+//
+// Acceptance Criteria:
+// - The zip contains the trace, the effective config, the version, and the output
+// - The trace records the API requests the command made
+// - The auth key does not appear in any entry, even where the server or command echoed it
+// - The command's error is returned and recorded in the output
+func TestRunWithSupportBundle(t *testing.T) {
+	const authKey = "sk-live-very-secret-key"
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Echo the Authorization header back so redaction of response bodies is exercised
+		fmt.Fprintf(w, `{"restUrlPrefix": "%s", "echo": "%s"}`, server.URL, r.Header.Get("Authorization"))
+	}))
+	defer server.Close()
+
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	configYAML := fmt.Sprintf("auth_key: %s\norg_id: org-123\nuser_email: me@example.com\nrest_directory_url: %s\n", authKey, server.URL)
+	os.MkdirAll(filepath.Join(tempDir, ".fb"), 0700)
+	if err := os.WriteFile(filepath.Join(tempDir, ".fb", "config.yaml"), []byte(configYAML), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	bundlePath := filepath.Join(tempDir, "out.zip")
+	cmdErr := runWithSupportBundle(bundlePath, "1.2.3", func(trace io.Writer) error {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			return err
+		}
+		if _, err := service.NewTicketService(context.Background(), cfg, service.Options{TraceOutput: trace}); err != nil {
+			return err
+		}
+		fmt.Printf("Using key %s\n", authKey)
+		return fmt.Errorf("boom")
+	})
+
+	if cmdErr == nil || cmdErr.Error() != "boom" {
+		t.Errorf("Expected the command error, got %v", cmdErr)
+	}
+
+	entries := readZipEntries(t, bundlePath)
+
+	for _, name := range []string{bundleVersionEntry, bundleConfigEntry, bundleTraceEntry, bundleOutputEntry} {
+		if _, ok := entries[name]; !ok {
+			t.Errorf("Expected bundle to contain %s, got %v", name, entries)
+		}
+	}
+	for name, content := range entries {
+		if strings.Contains(content, authKey) {
+			t.Errorf("Auth key leaked into %s:\n%s", name, content)
+		}
+	}
+
+	if !strings.Contains(entries[bundleVersionEntry], "1.2.3") {
+		t.Errorf("Expected version 1.2.3, got %q", entries[bundleVersionEntry])
+	}
+	if !strings.Contains(entries[bundleConfigEntry], "org_id: org-123") {
+		t.Errorf("Expected the effective config, got:\n%s", entries[bundleConfigEntry])
+	}
+	if !strings.Contains(entries[bundleTraceEntry], "> GET "+server.URL+"/org-123") {
+		t.Errorf("Expected the discovery request in the trace, got:\n%s", entries[bundleTraceEntry])
+	}
+	if !strings.Contains(entries[bundleOutputEntry], "Using key") || !strings.Contains(entries[bundleOutputEntry], "error: boom") {
+		t.Errorf("Expected the command output and error, got:\n%s", entries[bundleOutputEntry])
+	}
+}
+
+// TestExtractSupportBundlePath tests splitting --support-bundle off the command line
+func TestExtractSupportBundlePath(t *testing.T) {
+	t.Run("Given --support-bundle and a path When extracting Then the command follows", func(t *testing.T) {
+		path, rest, found := extractSupportBundlePath([]string{"--support-bundle", "out.zip", "status", "--with-counts"})
+
+		if !found || path != "out.zip" || strings.Join(rest, " ") != "status --with-counts" {
+			t.Errorf("Unexpected result: %q %v %v", path, rest, found)
+		}
+	})
+
+	t.Run("Given --support-bundle=path When extracting Then the path is split off", func(t *testing.T) {
+		path, rest, found := extractSupportBundlePath([]string{"--support-bundle=out.zip", "--bin", "Doing"})
+
+		if !found || path != "out.zip" || strings.Join(rest, " ") != "--bin Doing" {
+			t.Errorf("Unexpected result: %q %v %v", path, rest, found)
+		}
+	})
+
+	t.Run("Given no --support-bundle When extracting Then args are unchanged", func(t *testing.T) {
+		_, rest, found := extractSupportBundlePath([]string{"status"})
+
+		if found || len(rest) != 1 {
+			t.Errorf("Expected args unchanged, got %v %v", rest, found)
+		}
+	})
+}
+
+// readZipEntries returns the content of every file in the zip at path, keyed by name
+func readZipEntries(t *testing.T, path string) map[string]string {
+	t.Helper()
+
+	reader, err := zip.OpenReader(path)
+	if err != nil {
+		t.Fatalf("Failed to open bundle: %v", err)
+	}
+	defer reader.Close()
+
+	entries := map[string]string{}
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		if err != nil {
+			t.Fatalf("Failed to open %s: %v", file.Name, err)
+		}
+		data, _ := io.ReadAll(rc)
+		rc.Close()
+		entries[file.Name] = string(data)
+	}
+	return entries
+}
@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Germanicus1/fb/config"
+)
+
+// noColorEnv disables color when set to a non-empty value, see https://no-color.org
+const noColorEnv = "NO_COLOR"
+
+// stdoutIsTerminal reports whether stdout is a terminal; tests replace it
+var stdoutIsTerminal = func() bool {
+	return isTerminal(os.Stdout)
+}
+
+// stdoutColumns returns the width of the terminal on stdout, or 0 when stdout is not a
+// terminal or its size is unknown; tests replace it
+var stdoutColumns = func() int {
+	if !isTerminal(os.Stdout) {
+		return 0
+	}
+	return terminalColumns(os.Stdout)
+}
+
+// resolveWidth picks the line width for wrapped output: --width when given, then the
+// configured wrap_width (already validated at load time), otherwise the terminal width.
+// Zero leaves the formatter's 80-column default in place.
+func resolveWidth(flags *Flags, configured int) (int, error) {
+	if flags.Width != 0 && (flags.Width < config.MinWrapWidth || flags.Width > config.MaxWrapWidth) {
+		return 0, fmt.Errorf("--width must be between %d and %d columns, got %d", config.MinWrapWidth, config.MaxWrapWidth, flags.Width)
+	}
+	if flags.Width > 0 {
+		return flags.Width, nil
+	}
+	if configured > 0 {
+		return configured, nil
+	}
+	return stdoutColumns(), nil
+}
+
+// localeEnvs are the locale variables that set the character encoding, in order of precedence
+var localeEnvs = []string{"LC_ALL", "LC_CTYPE", "LANG"}
+
+// localeIsUTF8 reports whether the locale's character encoding is UTF-8, so non-ASCII markers
+// render correctly. Without any locale set, UTF-8 is assumed everywhere but on Windows, whose
+// legacy consoles use a code page.
+func localeIsUTF8(getenv func(string) string, goos string) bool {
+	for _, name := range localeEnvs {
+		if value := getenv(name); value != "" {
+			value = strings.ToLower(value)
+			return strings.Contains(value, "utf-8") || strings.Contains(value, "utf8")
+		}
+	}
+	return goos != "windows"
+}
+
+// isTerminal reports whether file is attached to a terminal rather than a pipe or file
+func isTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
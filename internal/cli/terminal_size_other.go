@@ -0,0 +1,10 @@
+//go:build !(linux || darwin || freebsd || netbsd || openbsd || dragonfly)
+
+package cli
+
+import "os"
+
+// terminalColumns is not supported on this platform, so output keeps the default width
+func terminalColumns(file *os.File) int {
+	return 0
+}
@@ -0,0 +1,101 @@
+package cli
+
+import "testing"
+
+// TestResolveWidth tests picking the wrap width for list output
+//
+// Acceptance Criteria:
+// - --width overrides the detected terminal width
+// - Without --width the terminal width is used, or 0 (the 80-column default) off a terminal
+// - wrap_width from the config is used without --width, overriding the terminal width
+// - A negative --width, or one outside the wrap_width bounds, is an error
+func TestResolveWidth(t *testing.T) {
+	originalColumns := stdoutColumns
+	defer func() { stdoutColumns = originalColumns }()
+
+	t.Run("Given --width When resolving Then it overrides the terminal width", func(t *testing.T) {
+		stdoutColumns = func() int { return 200 }
+
+		width, err := resolveWidth(&Flags{Width: 100}, 0)
+
+		if err != nil || width != 100 {
+			t.Errorf("Expected 100, got %d (%v)", width, err)
+		}
+	})
+
+	t.Run("Given a 132-column terminal When resolving Then use its width", func(t *testing.T) {
+		stdoutColumns = func() int { return 132 }
+
+		if width, _ := resolveWidth(&Flags{}, 0); width != 132 {
+			t.Errorf("Expected 132, got %d", width)
+		}
+	})
+
+	t.Run("Given no terminal When resolving Then leave the default", func(t *testing.T) {
+		stdoutColumns = func() int { return 0 }
+
+		if width, _ := resolveWidth(&Flags{}, 0); width != 0 {
+			t.Errorf("Expected 0, got %d", width)
+		}
+	})
+
+	t.Run("Given a negative --width When resolving Then return an error", func(t *testing.T) {
+		if _, err := resolveWidth(&Flags{Width: -5}, 0); err == nil {
+			t.Error("Expected an error for a negative width")
+		}
+	})
+
+	t.Run("Given wrap_width When resolving without --width Then it overrides the terminal width", func(t *testing.T) {
+		stdoutColumns = func() int { return 200 }
+
+		if width, _ := resolveWidth(&Flags{}, 120); width != 120 {
+			t.Errorf("Expected 120, got %d", width)
+		}
+		if width, _ := resolveWidth(&Flags{Width: 60}, 120); width != 60 {
+			t.Errorf("Expected --width 60 to win, got %d", width)
+		}
+	})
+
+	t.Run("Given an out-of-range --width When resolving Then return an error", func(t *testing.T) {
+		for _, width := range []int{5, 5000} {
+			if _, err := resolveWidth(&Flags{Width: width}, 0); err == nil {
+				t.Errorf("Expected an error for --width %d", width)
+			}
+		}
+	})
+}
+
+// TestLocaleIsUTF8 tests detecting whether the locale can render non-ASCII markers
+//
+// Acceptance Criteria:
+// - A UTF-8 locale in LC_ALL, LC_CTYPE, or LANG is detected, in either spelling
+// - LC_ALL takes precedence over LANG
+// - A C or Latin-1 locale is not UTF-8
+// - Without any locale, UTF-8 is assumed except on Windows
+func TestLocaleIsUTF8(t *testing.T) {
+	env := func(values map[string]string) func(string) string {
+		return func(name string) string { return values[name] }
+	}
+
+	tests := []struct {
+		name     string
+		values   map[string]string
+		goos     string
+		expected bool
+	}{
+		{"Given LANG en_US.UTF-8 When detecting Then UTF-8", map[string]string{"LANG": "en_US.UTF-8"}, "linux", true},
+		{"Given LC_CTYPE C.utf8 When detecting Then UTF-8", map[string]string{"LC_CTYPE": "C.utf8"}, "linux", true},
+		{"Given LC_ALL C over a UTF-8 LANG When detecting Then not UTF-8", map[string]string{"LC_ALL": "C", "LANG": "en_US.UTF-8"}, "linux", false},
+		{"Given LANG de_DE.ISO-8859-1 When detecting Then not UTF-8", map[string]string{"LANG": "de_DE.ISO-8859-1"}, "darwin", false},
+		{"Given no locale on Linux When detecting Then UTF-8 is assumed", nil, "linux", true},
+		{"Given no locale on Windows When detecting Then not UTF-8", nil, "windows", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := localeIsUTF8(env(tt.values), tt.goos); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package cli
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// formatVersion returns the version line printed by fb version and fb --version, including the
+// Go runtime and OS/arch like `go version` does, e.g. "fb version 1.3.0 go1.25.6 linux/amd64"
+func formatVersion(version string) string {
+	return fmt.Sprintf("fb version %s %s %s/%s\n", version, runtime.Version(), runtime.GOOS, runtime.GOARCH)
+}
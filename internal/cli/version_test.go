@@ -0,0 +1,20 @@
+package cli
+
+import (
+	"runtime"
+	"testing"
+)
+
+// TestFormatVersion tests the output of fb version and fb --version
+//
+// Acceptance Criteria:
+// - The line names the fb version, the Go runtime version, and the OS/arch
+func TestFormatVersion(t *testing.T) {
+	t.Run("Given a version When formatting Then include the Go runtime and platform", func(t *testing.T) {
+		expected := "fb version 1.3.0 " + runtime.Version() + " " + runtime.GOOS + "/" + runtime.GOARCH + "\n"
+
+		if got := formatVersion("1.3.0"); got != expected {
+			t.Errorf("Expected %q, got %q", expected, got)
+		}
+	})
+}
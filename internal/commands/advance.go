@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Germanicus1/fb/config"
+	"github.com/Germanicus1/fb/internal/service"
+	"github.com/Germanicus1/fb/internal/state"
+	"github.com/Germanicus1/fb/models"
+)
+
+// ExecuteAdvance moves the checked-out ticket to the next bin to the right in its board's
+// column order, or to the left when back is set
+func ExecuteAdvance(ctx context.Context, svcOpts service.Options, back bool) error {
+	checkout, err := state.LoadCheckout()
+	if err != nil {
+		return fmt.Errorf("no ticket checked out. Use 'fb checkout' first")
+	}
+	if checkout.BinID == "" {
+		return fmt.Errorf("the bin of checked-out ticket %s is unknown; check it out again to refresh it", checkout.TicketID)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	ticketService, err := service.NewTicketService(ctx, cfg, svcOpts)
+	if err != nil {
+		return err
+	}
+
+	boards, err := ticketService.GetBoards()
+	if err != nil {
+		return err
+	}
+
+	target, err := adjacentBinID(boards, checkout.BinID, back)
+	if err != nil {
+		return err
+	}
+
+	bins, err := ticketService.GetBins()
+	if err != nil {
+		return err
+	}
+	targetName := binNameByID(bins, target)
+
+	if err := ticketService.MoveTicket(checkout.TicketID, target); err != nil {
+		return err
+	}
+
+	// Keep the checkout display in sync with the ticket's new bin
+	checkout.BinID = target
+	checkout.BinName = targetName
+	if err := state.SaveCheckout(checkout); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Moved %s to '%s'\n", checkout.TicketID, targetName)
+	return nil
+}
+
+// adjacentBinID returns the ID of the bin next to binID in the column order of the board
+// that contains it: the one to the right, or to the left when back is set
+func adjacentBinID(boards []models.Board, binID string, back bool) (string, error) {
+	for _, board := range boards {
+		for i, id := range board.Bins {
+			if id != binID {
+				continue
+			}
+			if back {
+				if i == 0 {
+					return "", fmt.Errorf("ticket is already in the first bin of board '%s'", board.Name)
+				}
+				return board.Bins[i-1], nil
+			}
+			if i == len(board.Bins)-1 {
+				return "", fmt.Errorf("ticket is already in the last bin of board '%s'", board.Name)
+			}
+			return board.Bins[i+1], nil
+		}
+	}
+	return "", fmt.Errorf("board order unknown for bin %s: it is not a column of any board", binID)
+}
+
+// binNameByID returns the name of the bin with the given ID, or the ID itself when it is not listed
+func binNameByID(bins []models.Bin, binID string) string {
+	for _, bin := range bins {
+		if bin.ID == binID {
+			return bin.Name
+		}
+	}
+	return binID
+}
@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestAdjacentBinID tests picking the target bin for `fb advance`
+//
+// Acceptance Criteria:
+// - Advancing moves to the next bin to the right in the board's column order
+// - --back moves to the bin on the left
+// - Advancing past the last bin, or back past the first, is an error naming the board
+// - A bin that is not a column of any board is an error
+func TestAdjacentBinID(t *testing.T) {
+	boards := []models.Board{
+		{ID: "board-1", Name: "Roadmap", Bins: []string{"idea", "planned"}},
+		{ID: "board-2", Name: "Sprint", Bins: []string{"todo", "doing", "done"}},
+	}
+
+	t.Run("Given a ticket in To Do When advancing Then the next bin is In Progress", func(t *testing.T) {
+		target, err := adjacentBinID(boards, "todo", false)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if target != "doing" {
+			t.Errorf("Expected doing, got %s", target)
+		}
+	})
+
+	t.Run("Given a ticket in the last bin When advancing Then return a boundary error", func(t *testing.T) {
+		_, err := adjacentBinID(boards, "done", false)
+
+		if err == nil || !strings.Contains(err.Error(), "already in the last bin of board 'Sprint'") {
+			t.Errorf("Expected last bin error, got %v", err)
+		}
+	})
+
+	t.Run("Given a ticket in a middle bin When moving back Then the previous bin is chosen", func(t *testing.T) {
+		target, err := adjacentBinID(boards, "doing", true)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if target != "todo" {
+			t.Errorf("Expected todo, got %s", target)
+		}
+	})
+
+	t.Run("Given a ticket in the first bin When moving back Then return a boundary error", func(t *testing.T) {
+		_, err := adjacentBinID(boards, "idea", true)
+
+		if err == nil || !strings.Contains(err.Error(), "already in the first bin of board 'Roadmap'") {
+			t.Errorf("Expected first bin error, got %v", err)
+		}
+	})
+
+	t.Run("Given a bin on no board When advancing Then report unknown board order", func(t *testing.T) {
+		_, err := adjacentBinID(boards, "orphan", false)
+
+		if err == nil || !strings.Contains(err.Error(), "board order unknown") {
+			t.Errorf("Expected unknown order error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Germanicus1/fb/internal/service"
+	"github.com/Germanicus1/fb/models"
+)
+
+// promptForBins lists the available bins and asks the user to pick the ones to filter by.
+// It refuses to prompt when stdin is not an interactive terminal.
+func promptForBins(ticketService *service.TicketService) ([]models.Bin, error) {
+	if !isInteractiveTerminal(os.Stdin) {
+		return nil, fmt.Errorf("--bin requires a value when not running in an interactive terminal")
+	}
+
+	bins, err := ticketService.GetBins()
+	if err != nil {
+		return nil, err
+	}
+	return selectBins(os.Stdin, os.Stdout, bins)
+}
+
+// isInteractiveTerminal reports whether file is attached to a terminal rather than a pipe or file
+func isInteractiveTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// selectBins displays a numbered list of bins and reads one or more selections,
+// separated by commas or spaces (e.g. "1,3" or "1 3")
+func selectBins(input io.Reader, output io.Writer, bins []models.Bin) ([]models.Bin, error) {
+	if len(bins) == 0 {
+		return nil, fmt.Errorf("no bins available to select from")
+	}
+
+	for i, bin := range bins {
+		fmt.Fprintf(output, "%d. %s\n", i+1, bin.Name)
+	}
+
+	scanner := bufio.NewScanner(input)
+	for {
+		fmt.Fprintf(output, "Enter bin number(s) to filter by (e.g. 1,3): ")
+
+		if !scanner.Scan() || strings.TrimSpace(scanner.Text()) == "" {
+			return nil, fmt.Errorf("operation cancelled")
+		}
+
+		selected, err := parseBinSelection(scanner.Text(), bins)
+		if err != nil {
+			fmt.Fprintf(output, "%v\n", err)
+			continue
+		}
+		return selected, nil
+	}
+}
+
+// parseBinSelection converts a list of 1-based bin numbers into the selected bins, skipping repeats
+func parseBinSelection(text string, bins []models.Bin) ([]models.Bin, error) {
+	var selected []models.Bin
+	seen := map[int]bool{}
+
+	for _, field := range strings.FieldsFunc(text, func(r rune) bool { return r == ',' || r == ' ' }) {
+		number, err := strconv.Atoi(field)
+		if err != nil || number < 1 || number > len(bins) {
+			return nil, fmt.Errorf("invalid bin number '%s', please enter numbers between 1 and %d", field, len(bins))
+		}
+		if seen[number] {
+			continue
+		}
+		seen[number] = true
+		selected = append(selected, bins[number-1])
+	}
+	return selected, nil
+}
+
+// filterBySelectedBins keeps the tickets that belong to any of the selected bins
+func filterBySelectedBins(tickets []models.Ticket, bins []models.Bin) []models.Ticket {
+	binIDs := make(map[string]bool, len(bins))
+	for _, bin := range bins {
+		binIDs[bin.ID] = true
+	}
+
+	result := []models.Ticket{}
+	for _, ticket := range tickets {
+		if binIDs[ticket.BinID] {
+			result = append(result, ticket)
+		}
+	}
+	return result
+}
+
+// binNames joins the names of the selected bins for display
+func binNames(bins []models.Bin) string {
+	names := make([]string, len(bins))
+	for i, bin := range bins {
+		names[i] = bin.Name
+	}
+	return strings.Join(names, ", ")
+}
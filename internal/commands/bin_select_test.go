@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestInteractiveBinSelection tests picking bins when --bin is given without a value
+//
+// Acceptance Criteria:
+// - Available bins are listed with numbers
+// - One or more bins can be selected, separated by commas or spaces
+// - Invalid numbers re-prompt; empty input cancels
+// - Only tickets in the chosen bins remain after filtering
+func TestInteractiveBinSelection(t *testing.T) {
+	bins := []models.Bin{
+		{ID: "bin-todo", Name: "To Do"},
+		{ID: "bin-doing", Name: "Doing"},
+		{ID: "bin-done", Name: "Done"},
+	}
+
+	tickets := []models.Ticket{
+		{ID: "T-1", Name: "First", BinID: "bin-todo", BinName: "To Do"},
+		{ID: "T-2", Name: "Second", BinID: "bin-doing", BinName: "Doing"},
+		{ID: "T-3", Name: "Third", BinID: "bin-done", BinName: "Done"},
+		{ID: "T-4", Name: "Fourth", BinID: "bin-todo", BinName: "To Do"},
+	}
+
+	t.Run("Given a multi-bin selection When filtering Then only tickets in the chosen bins remain", func(t *testing.T) {
+		var output bytes.Buffer
+		selected, err := selectBins(strings.NewReader("1,3\n"), &output, bins)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if !strings.Contains(output.String(), "1. To Do") || !strings.Contains(output.String(), "3. Done") {
+			t.Errorf("Expected numbered bin list, got:\n%s", output.String())
+		}
+		if binNames(selected) != "To Do, Done" {
+			t.Errorf("Expected To Do and Done, got %q", binNames(selected))
+		}
+
+		filtered := filterBySelectedBins(tickets, selected)
+		var ids []string
+		for _, ticket := range filtered {
+			ids = append(ids, ticket.ID)
+		}
+		if strings.Join(ids, ",") != "T-1,T-3,T-4" {
+			t.Errorf("Expected T-1,T-3,T-4, got %v", ids)
+		}
+	})
+
+	t.Run("Given an invalid number When selecting Then the user is prompted again", func(t *testing.T) {
+		var output bytes.Buffer
+		selected, err := selectBins(strings.NewReader("7\n2\n"), &output, bins)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if !strings.Contains(output.String(), "invalid bin number '7'") {
+			t.Errorf("Expected invalid number message, got:\n%s", output.String())
+		}
+		if len(selected) != 1 || selected[0].ID != "bin-doing" {
+			t.Errorf("Expected Doing to be selected, got %v", selected)
+		}
+	})
+
+	t.Run("Given a repeated number When selecting Then the bin is selected once", func(t *testing.T) {
+		selected, err := selectBins(strings.NewReader("2 2\n"), &bytes.Buffer{}, bins)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(selected) != 1 {
+			t.Errorf("Expected 1 bin, got %d", len(selected))
+		}
+	})
+
+	t.Run("Given empty input When selecting Then the operation is cancelled", func(t *testing.T) {
+		if _, err := selectBins(strings.NewReader("\n"), &bytes.Buffer{}, bins); err == nil {
+			t.Error("Expected cancellation error, got nil")
+		}
+	})
+}
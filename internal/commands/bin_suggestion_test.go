@@ -0,0 +1,41 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestBinSuggestion tests the "Did you mean" message for a --bin value that matched nothing
+//
+// Acceptance Criteria:
+// - A bin name within two edits is suggested in the message
+// - A value naming a bin exactly, by name or ID, gets no suggestion
+// - A value far from every bin name gets no suggestion
+func TestBinSuggestion(t *testing.T) {
+	bins := []models.Bin{
+		{ID: "binTodo", Name: "To Do"},
+		{ID: "binProgress", Name: "In Progress"},
+	}
+
+	t.Run("Given a typo When suggesting Then the message names the closest bin", func(t *testing.T) {
+		expected := "No tickets matched bin 'In Progres'. Did you mean 'In Progress'?"
+		if got := binSuggestion(bins, "In Progres"); got != expected {
+			t.Errorf("Expected %q, got %q", expected, got)
+		}
+	})
+
+	t.Run("Given an existing bin name or ID When suggesting Then there is no suggestion", func(t *testing.T) {
+		for _, value := range []string{"in progress", "binTodo"} {
+			if got := binSuggestion(bins, value); got != "" {
+				t.Errorf("Expected no suggestion for %q, got %q", value, got)
+			}
+		}
+	})
+
+	t.Run("Given a value far from every bin When suggesting Then there is no suggestion", func(t *testing.T) {
+		if got := binSuggestion(bins, "Archive"); got != "" {
+			t.Errorf("Expected no suggestion, got %q", got)
+		}
+	})
+}
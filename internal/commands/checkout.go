@@ -2,7 +2,9 @@ package commands
 
 import (
 	"bufio"
+	"context"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"time"
@@ -13,24 +15,33 @@ import (
 	"github.com/Germanicus1/fb/models"
 )
 
-// ExecuteCheckout handles the checkout command with optional bin filter and ticket ID
-func ExecuteCheckout(args []string, binFlag string, forceFlag bool) error {
+// ExecuteCheckout handles the checkout command with optional bin filter and ticket ID,
+// or checks out the most recently updated ticket when latest is set. With dryRun, the
+// ticket is validated and reported but no checkout state is written.
+func ExecuteCheckout(ctx context.Context, svcOpts service.Options, args []string, binFlag string, forceFlag, latest, dryRun bool) error {
+	if latest {
+		if len(args) > 0 || binFlag != "" {
+			return fmt.Errorf("--latest cannot be combined with a ticket ID or --bin")
+		}
+		return ExecuteLatestCheckout(ctx, svcOpts, forceFlag, dryRun)
+	}
+
 	if len(args) > 0 {
 		// Direct checkout by ticket ID
-		return ExecuteDirectCheckout(args[0])
+		return ExecuteDirectCheckout(ctx, svcOpts, args[0], dryRun)
 	}
 
 	// Checkout with bin filter or use last bin context
 	if binFlag != "" {
-		return ExecuteBinCheckout(binFlag, forceFlag)
+		return ExecuteBinCheckout(ctx, svcOpts, binFlag, forceFlag, dryRun)
 	}
 
 	// No arguments - use last bin context
-	return ExecuteCheckoutWithLastBin()
+	return ExecuteCheckoutWithLastBin(ctx, svcOpts, dryRun)
 }
 
 // ExecuteBinCheckout checks out a ticket from a specific bin
-func ExecuteBinCheckout(binName string, force bool) error {
+func ExecuteBinCheckout(ctx context.Context, svcOpts service.Options, binName string, force, dryRun bool) error {
 	// Check for existing checkout
 	if !force {
 		if existing, err := state.LoadCheckout(); err == nil {
@@ -44,7 +55,7 @@ func ExecuteBinCheckout(binName string, force bool) error {
 		return err
 	}
 
-	ticketService, err := service.NewTicketService(cfg)
+	ticketService, err := service.NewTicketService(ctx, cfg, svcOpts)
 	if err != nil {
 		return err
 	}
@@ -56,13 +67,13 @@ func ExecuteBinCheckout(binName string, force bool) error {
 	}
 
 	// Resolve bin name to ID
-	binID, err := service.ResolveBinFilter(ticketService.GetClient(), binName)
+	bin, err := service.ResolveBinFilter(ticketService.GetClient(), binName)
 	if err != nil {
 		return err
 	}
 
 	// Fetch tickets in this bin
-	tickets, err := ticketService.GetUserTicketsFiltered(user.ID, binID, "")
+	tickets, err := ticketService.GetUserTicketsFiltered(user.ID, bin.ID, "")
 	if err != nil {
 		return err
 	}
@@ -94,6 +105,10 @@ func ExecuteBinCheckout(binName string, force bool) error {
 	}
 
 	selectedTicket := tickets[selection-1]
+	if dryRun {
+		fmt.Print("\n" + formatDryRunCheckout(selectedTicket))
+		return nil
+	}
 
 	// Save checkout state
 	checkout := state.CheckoutState{
@@ -109,7 +124,7 @@ func ExecuteBinCheckout(binName string, force bool) error {
 	}
 
 	// Save bin context
-	if err := state.SaveBinContext(binID, binName); err != nil {
+	if err := state.SaveBinContext(bin.ID, binName); err != nil {
 		return err
 	}
 
@@ -117,8 +132,13 @@ func ExecuteBinCheckout(binName string, force bool) error {
 	return nil
 }
 
-// ExecuteDirectCheckout checks out a ticket by ID
-func ExecuteDirectCheckout(ticketID string) error {
+// ExecuteDirectCheckout checks out a ticket by ID, or by a unique case-insensitive
+// substring of its name when no assigned ticket has that ID
+func ExecuteDirectCheckout(ctx context.Context, svcOpts service.Options, ticketID string, dryRun bool) error {
+	if err := validateCheckoutQuery(ticketID); err != nil {
+		return err
+	}
+
 	// Check for existing checkout
 	if existing, err := state.LoadCheckout(); err == nil {
 		return fmt.Errorf("ticket already checked out: %s\nUse 'fb clear' first", existing.TicketName)
@@ -130,7 +150,7 @@ func ExecuteDirectCheckout(ticketID string) error {
 		return err
 	}
 
-	ticketService, err := service.NewTicketService(cfg)
+	ticketService, err := service.NewTicketService(ctx, cfg, svcOpts)
 	if err != nil {
 		return err
 	}
@@ -141,22 +161,108 @@ func ExecuteDirectCheckout(ticketID string) error {
 		return err
 	}
 
-	// Fetch all user tickets and find the one with matching ID
+	// Fetch all user tickets and find the one with matching ID or name
 	tickets, err := ticketService.GetUserTickets(user.ID)
 	if err != nil {
 		return err
 	}
 
-	var selectedTicket *models.Ticket
-	for _, ticket := range tickets {
-		if ticket.ID == ticketID {
-			selectedTicket = &ticket
-			break
+	selectedTicket, err := findTicketByIDOrName(tickets, ticketID)
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		fmt.Print(formatDryRunCheckout(*selectedTicket))
+		return nil
+	}
+
+	// Save checkout state
+	checkout := state.CheckoutState{
+		TicketID:     selectedTicket.ID,
+		TicketName:   selectedTicket.Name,
+		BinID:        selectedTicket.BinID,
+		BinName:      selectedTicket.BinName,
+		CheckedOutAt: time.Now().Format(time.RFC3339),
+	}
+
+	if err := state.SaveCheckout(&checkout); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Checked out: %s\n", selectedTicket.Name)
+	return nil
+}
+
+// findTicketByIDOrName returns the ticket whose ID is exactly query or, failing that, the only
+// ticket whose name contains query case-insensitively. Several name matches are an error
+// listing them so the user can pick one by ID.
+func findTicketByIDOrName(tickets []models.Ticket, query string) (*models.Ticket, error) {
+	for i := range tickets {
+		if tickets[i].ID == query {
+			return &tickets[i], nil
+		}
+	}
+
+	needle := strings.ToLower(query)
+	var matches []*models.Ticket
+	for i := range tickets {
+		if strings.Contains(strings.ToLower(tickets[i].Name), needle) {
+			matches = append(matches, &tickets[i])
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("ticket %s not found or not assigned to you", query)
+	case 1:
+		return matches[0], nil
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "'%s' matches %d tickets; check out one by ID:", query, len(matches))
+	for _, ticket := range matches {
+		fmt.Fprintf(&b, "\n  [%s] %s", ticket.ID, ticket.Name)
+	}
+	return nil, fmt.Errorf("%s", b.String())
+}
+
+// ExecuteLatestCheckout checks out the assigned ticket that was updated most recently
+func ExecuteLatestCheckout(ctx context.Context, svcOpts service.Options, force, dryRun bool) error {
+	// Check for existing checkout
+	if !force {
+		if existing, err := state.LoadCheckout(); err == nil {
+			return fmt.Errorf("ticket already checked out: %s\nUse 'fb clear' or 'fb checkout --latest --force'", existing.TicketName)
 		}
 	}
 
+	// Load config and initialize API
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	ticketService, err := service.NewTicketService(ctx, cfg, svcOpts)
+	if err != nil {
+		return err
+	}
+
+	user, err := ticketService.GetCurrentUser(cfg.UserEmail)
+	if err != nil {
+		return err
+	}
+
+	tickets, err := ticketService.GetUserTickets(user.ID)
+	if err != nil {
+		return err
+	}
+
+	selectedTicket := selectLatestTicket(tickets)
 	if selectedTicket == nil {
-		return fmt.Errorf("ticket %s not found or not assigned to you", ticketID)
+		return fmt.Errorf("no tickets assigned to you to check out")
+	}
+	if dryRun {
+		fmt.Print(formatDryRunCheckout(*selectedTicket))
+		return nil
 	}
 
 	// Save checkout state
@@ -172,25 +278,59 @@ func ExecuteDirectCheckout(ticketID string) error {
 		return err
 	}
 
-	fmt.Printf("✓ Checked out: %s\n", selectedTicket.Name)
+	fmt.Printf("✓ Checked out latest: [%s] %s\n", selectedTicket.ID, selectedTicket.Name)
 	return nil
 }
 
+// formatDryRunCheckout describes the checkout a dry run would have made
+func formatDryRunCheckout(ticket models.Ticket) string {
+	return fmt.Sprintf("[dry-run] Would check out %s (%s)\n", ticket.ID, ticket.Name)
+}
+
+// selectLatestTicket returns the ticket with the newest UpdatedAt, breaking ties by
+// the lowest ID so the choice is deterministic. It returns nil for an empty list.
+func selectLatestTicket(tickets []models.Ticket) *models.Ticket {
+	var latest *models.Ticket
+	for i := range tickets {
+		ticket := &tickets[i]
+		if latest == nil ||
+			ticket.UpdatedAt.After(latest.UpdatedAt) ||
+			(ticket.UpdatedAt.Equal(latest.UpdatedAt) && ticket.ID < latest.ID) {
+			latest = ticket
+		}
+	}
+	return latest
+}
+
 // ExecuteCheckoutWithLastBin checks out using the last used bin context
-func ExecuteCheckoutWithLastBin() error {
+func ExecuteCheckoutWithLastBin(ctx context.Context, svcOpts service.Options, dryRun bool) error {
 	binContext, err := state.LoadBinContext()
 	if err != nil {
 		return fmt.Errorf("no bin context found. Use 'fb checkout --bin \"Bin Name\"' first")
 	}
 
-	return ExecuteBinCheckout(binContext.BinName, false)
+	return ExecuteBinCheckout(ctx, svcOpts, binContext.BinName, false, dryRun)
 }
 
 // ExecuteClear clears the current checkout state
 func ExecuteClear() error {
+	return runCheckoutClear(os.Stdout)
+}
+
+// runCheckoutClear deletes the checkout state and names the ticket that was released.
+// Having nothing checked out is not an error.
+func runCheckoutClear(output io.Writer) error {
+	checkout, loadErr := state.LoadCheckout()
+
+	// Remove the file even when it could not be read, so a corrupt checkout can be cleared
 	if err := state.ClearCheckout(); err != nil {
 		return err
 	}
-	fmt.Println("✓ Checkout cleared")
+
+	if loadErr != nil {
+		fmt.Fprintln(output, "No ticket currently checked out.")
+		return nil
+	}
+	fmt.Fprintf(output, "Released checkout of %s (%s).\n", checkout.TicketID, checkout.TicketName)
 	return nil
 }
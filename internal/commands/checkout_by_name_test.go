@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestFindTicketByIDOrName tests picking the ticket to check out from an ID or a name
+//
+// Acceptance Criteria:
+// - An exact ID match wins over name matches
+// - Otherwise a single case-insensitive name substring match is chosen
+// - Several name matches are an error listing their IDs and names
+// - No match returns the existing not-found error
+func TestFindTicketByIDOrName(t *testing.T) {
+	tickets := []models.Ticket{
+		{ID: "yL4rjYNU5", Name: "Fix login bug"},
+		{ID: "Qm81xZpA2", Name: "Login page redesign"},
+		{ID: "login", Name: "Update docs"},
+	}
+
+	t.Run("Given an exact ticket ID When checking out Then that ticket is chosen", func(t *testing.T) {
+		ticket, err := findTicketByIDOrName(tickets, "login")
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if ticket.ID != "login" {
+			t.Errorf("Expected ticket login, got %s", ticket.ID)
+		}
+	})
+
+	t.Run("Given a unique name substring in another case When checking out Then that ticket is chosen", func(t *testing.T) {
+		ticket, err := findTicketByIDOrName(tickets, "PAGE REDESIGN")
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if ticket.ID != "Qm81xZpA2" {
+			t.Errorf("Expected ticket Qm81xZpA2, got %s", ticket.ID)
+		}
+	})
+
+	t.Run("Given a name substring matching several tickets When checking out Then list the matches", func(t *testing.T) {
+		_, err := findTicketByIDOrName(tickets, "Login ")
+
+		if err == nil {
+			t.Fatal("Expected an ambiguity error")
+		}
+		for _, want := range []string{"matches 2 tickets", "[yL4rjYNU5] Fix login bug", "[Qm81xZpA2] Login page redesign"} {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("Expected error to contain %q, got %v", want, err)
+			}
+		}
+	})
+
+	t.Run("Given no matching ID or name When checking out Then return not found", func(t *testing.T) {
+		_, err := findTicketByIDOrName(tickets, "billing")
+
+		if err == nil || err.Error() != "ticket billing not found or not assigned to you" {
+			t.Errorf("Expected not found error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Germanicus1/fb/internal/state"
+)
+
+// TestCheckoutClear tests releasing the checked-out ticket
+//
+// Acceptance Criteria:
+// - Clearing deletes ~/.fb/checkout.json and names the released ticket
+// - Clearing with nothing checked out prints a notice and is not an error
+func TestCheckoutClear(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("HOME", tempDir)
+
+	t.Run("Given a checked-out ticket When clearing Then the checkout is released by name", func(t *testing.T) {
+		checkout := &state.CheckoutState{
+			TicketID:     "TICKET-001",
+			TicketName:   "Fix login bug",
+			CheckedOutAt: time.Now().Format(time.RFC3339),
+		}
+		if err := state.SaveCheckout(checkout); err != nil {
+			t.Fatalf("Failed to save checkout: %v", err)
+		}
+
+		var output bytes.Buffer
+		if err := runCheckoutClear(&output); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if output.String() != "Released checkout of TICKET-001 (Fix login bug).\n" {
+			t.Errorf("Unexpected output: %q", output.String())
+		}
+		if _, err := os.Stat(filepath.Join(tempDir, ".fb", "checkout.json")); !os.IsNotExist(err) {
+			t.Errorf("Expected checkout.json to be deleted, stat returned %v", err)
+		}
+	})
+
+	t.Run("Given no checkout When clearing Then report nothing is checked out", func(t *testing.T) {
+		var output bytes.Buffer
+		if err := runCheckoutClear(&output); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if output.String() != "No ticket currently checked out.\n" {
+			t.Errorf("Unexpected output: %q", output.String())
+		}
+	})
+}
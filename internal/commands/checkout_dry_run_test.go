@@ -0,0 +1,22 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestFormatDryRunCheckout tests the line printed by fb checkout --dry-run
+//
+// Acceptance Criteria:
+// - The line is marked [dry-run] and names the ticket ID and name it would check out
+func TestFormatDryRunCheckout(t *testing.T) {
+	t.Run("Given a valid ticket When checking out with --dry-run Then describe the checkout", func(t *testing.T) {
+		ticket := models.Ticket{ID: "TICKET-001", Name: "Fix login bug"}
+
+		expected := "[dry-run] Would check out TICKET-001 (Fix login bug)\n"
+		if got := formatDryRunCheckout(ticket); got != expected {
+			t.Errorf("Expected %q, got %q", expected, got)
+		}
+	})
+}
@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/internal/state"
+)
+
+// TestASCIICheckoutIndicator tests the ASCII fallback for the checkout indicator
+//
+// Acceptance Criteria:
+// - By default the checked-out ticket is marked with "← CHECKED OUT"
+// - In ASCII mode it is marked with "<-- CHECKED OUT" and no arrow character
+// - extractIndicatorText finds the indicator in either form
+func TestASCIICheckoutIndicator(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	if err := os.MkdirAll(filepath.Join(home, ".fb"), 0700); err != nil {
+		t.Fatalf("Failed to create .fb: %v", err)
+	}
+	if err := state.SaveCheckout(&state.CheckoutState{TicketID: "TICKET-002", TicketName: "Second"}); err != nil {
+		t.Fatalf("Failed to save checkout: %v", err)
+	}
+	output := "[TICKET-001] First\n[TICKET-002] Second\n"
+
+	t.Run("Given a UTF-8 terminal When marking the checkout Then the arrow is used", func(t *testing.T) {
+		marked := addCheckoutIndicator(output, false)
+
+		if !strings.Contains(marked, "[TICKET-002] Second ← CHECKED OUT") {
+			t.Errorf("Expected the arrow indicator, got:\n%s", marked)
+		}
+		if got := extractIndicatorText(marked); got != "← CHECKED OUT" {
+			t.Errorf("Expected to extract the arrow indicator, got %q", got)
+		}
+	})
+
+	t.Run("Given ASCII mode When marking the checkout Then the ASCII marker is used", func(t *testing.T) {
+		marked := addCheckoutIndicator(output, true)
+
+		if !strings.Contains(marked, "[TICKET-002] Second <-- CHECKED OUT") {
+			t.Errorf("Expected the ASCII indicator, got:\n%s", marked)
+		}
+		if strings.Contains(marked, "←") {
+			t.Errorf("Expected no arrow character in ASCII mode, got:\n%s", marked)
+		}
+		if got := extractIndicatorText(marked); got != "<-- CHECKED OUT" {
+			t.Errorf("Expected to extract the ASCII indicator, got %q", got)
+		}
+	})
+}
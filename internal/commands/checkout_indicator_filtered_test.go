@@ -286,12 +286,13 @@ func extractIndicatorText(output string) string {
 	lines := strings.Split(output, "\n")
 	for _, line := range lines {
 		if strings.Contains(line, "CHECKED OUT") {
-			// Extract the indicator portion
-			idx := strings.Index(line, "←")
-			if idx != -1 {
-				return strings.TrimSpace(line[idx:])
+			// Extract the indicator portion, in its Unicode or ASCII form
+			for _, arrow := range []string{"←", "<--"} {
+				if idx := strings.Index(line, arrow); idx != -1 {
+					return strings.TrimSpace(line[idx:])
+				}
 			}
-			idx = strings.Index(line, "CHECKED OUT")
+			idx := strings.Index(line, "CHECKED OUT")
 			if idx != -1 {
 				return strings.TrimSpace(line[idx:])
 			}
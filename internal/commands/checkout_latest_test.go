@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestSelectLatestTicket tests picking the ticket for `fb checkout --latest`
+//
+// Acceptance Criteria:
+// - The ticket with the newest UpdatedAt is selected
+// - Ties on UpdatedAt are broken deterministically by the lowest ID
+// - An empty list selects nothing
+func TestSelectLatestTicket(t *testing.T) {
+	base := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Given several tickets When selecting the latest Then the most recently updated one is chosen", func(t *testing.T) {
+		tickets := []models.Ticket{
+			{ID: "TICKET-001", UpdatedAt: base.Add(-48 * time.Hour)},
+			{ID: "TICKET-002", UpdatedAt: base},
+			{ID: "TICKET-003"},
+			{ID: "TICKET-004", UpdatedAt: base.Add(-time.Hour)},
+		}
+
+		latest := selectLatestTicket(tickets)
+
+		if latest == nil || latest.ID != "TICKET-002" {
+			t.Errorf("Expected TICKET-002, got %v", latest)
+		}
+	})
+
+	t.Run("Given tickets sharing the newest timestamp When selecting the latest Then the lowest ID wins", func(t *testing.T) {
+		tickets := []models.Ticket{
+			{ID: "TICKET-009", UpdatedAt: base},
+			{ID: "TICKET-003", UpdatedAt: base},
+			{ID: "TICKET-005", UpdatedAt: base},
+		}
+
+		latest := selectLatestTicket(tickets)
+
+		if latest == nil || latest.ID != "TICKET-003" {
+			t.Errorf("Expected TICKET-003, got %v", latest)
+		}
+	})
+
+	t.Run("Given no tickets When selecting the latest Then nothing is selected", func(t *testing.T) {
+		if latest := selectLatestTicket(nil); latest != nil {
+			t.Errorf("Expected nil, got %v", latest)
+		}
+	})
+}
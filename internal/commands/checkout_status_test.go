@@ -208,7 +208,7 @@ func runCheckoutStatus(output *bytes.Buffer) error {
 	return nil
 }
 
-// formatTimeSince converts a Unix timestamp to human-readable "X time ago" format
+// formatTimeSince converts a stored checkout timestamp to human-readable "X time ago" format
 func formatTimeSince(timestampStr string) string {
 	timestamp, err := parseTimestamp(timestampStr)
 	if err != nil {
@@ -219,11 +219,13 @@ func formatTimeSince(timestampStr string) string {
 	return humanizeDuration(duration)
 }
 
-// parseTimestamp parses a timestamp string to int64
+// parseTimestamp parses an RFC3339 or Unix-seconds timestamp string to Unix seconds
 func parseTimestamp(timestampStr string) (int64, error) {
-	var timestamp int64
-	_, err := fmt.Sscanf(timestampStr, "%d", &timestamp)
-	return timestamp, err
+	checkedOutTime, err := parseCheckedOutAt(timestampStr)
+	if err != nil {
+		return 0, err
+	}
+	return checkedOutTime.Unix(), nil
 }
 
 // humanizeDuration converts a duration to human-readable format
@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// TestCheckedOutAtFormats tests that both stored checkout timestamp formats are understood
+//
+// Acceptance Criteria:
+// - A Unix-seconds CheckedOutAt produces an "X ago" string
+// - An RFC3339 CheckedOutAt produces the same "X ago" string instead of "unknown time"
+// - Anything else is rejected so callers can skip the duration
+func TestCheckedOutAtFormats(t *testing.T) {
+	twoHoursAgo := time.Now().Add(-2 * time.Hour)
+
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"Given a Unix-seconds timestamp When formatting time since Then it reads 2 hours ago", fmt.Sprintf("%d", twoHoursAgo.Unix())},
+		{"Given an RFC3339 timestamp When formatting time since Then it reads 2 hours ago", twoHoursAgo.Format(time.RFC3339)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatTimeSince(tt.value); got != "2 hours ago" {
+				t.Errorf("Expected '2 hours ago', got %q", got)
+			}
+
+			parsed, err := parseCheckedOutAt(tt.value)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if parsed.Unix() != twoHoursAgo.Unix() {
+				t.Errorf("Expected %d, got %d", twoHoursAgo.Unix(), parsed.Unix())
+			}
+		})
+	}
+
+	t.Run("Given a malformed timestamp When parsing Then an error is returned", func(t *testing.T) {
+		if _, err := parseCheckedOutAt("yesterday"); err == nil {
+			t.Error("Expected an error for a malformed timestamp")
+		}
+		if got := formatTimeSince("yesterday"); got != "unknown time" {
+			t.Errorf("Expected 'unknown time', got %q", got)
+		}
+	})
+}
@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strings"
+)
+
+// Clipboard copies text to a clipboard
+type Clipboard interface {
+	Copy(text string) error
+}
+
+// systemClipboard copies text using the platform clipboard utility
+type systemClipboard struct{}
+
+// clipboardCommands lists the clipboard utilities to try on each platform, in order
+var clipboardCommands = map[string][][]string{
+	"darwin":  {{"pbcopy"}},
+	"windows": {{"clip"}},
+	"linux":   {{"wl-copy"}, {"xclip", "-selection", "clipboard"}, {"xsel", "--clipboard", "--input"}},
+}
+
+// Copy pipes text into the first available clipboard utility for this platform
+func (systemClipboard) Copy(text string) error {
+	for _, command := range clipboardCommands[runtime.GOOS] {
+		path, err := exec.LookPath(command[0])
+		if err != nil {
+			continue
+		}
+		cmd := exec.Command(path, command[1:]...)
+		cmd.Stdin = strings.NewReader(text)
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("%s failed: %w", command[0], err)
+		}
+		return nil
+	}
+	return fmt.Errorf("no clipboard utility found (install pbcopy, wl-copy, xclip, xsel, or clip)")
+}
+
+// ansiEscape matches ANSI color escape sequences
+var ansiEscape = regexp.MustCompile("\033\\[[0-9;]*m")
+
+// copyOutput copies rendered output to the clipboard without color codes.
+// A clipboard failure is reported as a warning rather than failing the command.
+func copyOutput(clipboard Clipboard, output string, warnings io.Writer) {
+	if err := clipboard.Copy(ansiEscape.ReplaceAllString(output, "")); err != nil {
+		fmt.Fprintf(warnings, "Warning: could not copy to clipboard: %v\n", err)
+	}
+}
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// fakeClipboard records copied text, or fails with err when set
+type fakeClipboard struct {
+	copied string
+	err    error
+}
+
+func (f *fakeClipboard) Copy(text string) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.copied = text
+	return nil
+}
+
+// TestCopyOutput tests copying the rendered list with --copy
+//
+// Acceptance Criteria:
+// - The rendered text is what gets copied, without color codes
+// - A missing clipboard tool produces a warning instead of an error
+func TestCopyOutput(t *testing.T) {
+	t.Run("Given rendered output When copying Then the clipboard receives the same text", func(t *testing.T) {
+		clipboard := &fakeClipboard{}
+		rendered := "Found 1 ticket(s) assigned to you:\n\n[TICKET-001] Fix login bug\n"
+
+		var warnings bytes.Buffer
+		copyOutput(clipboard, rendered, &warnings)
+
+		if clipboard.copied != rendered {
+			t.Errorf("Expected %q to be copied, got %q", rendered, clipboard.copied)
+		}
+		if warnings.Len() != 0 {
+			t.Errorf("Expected no warnings, got %q", warnings.String())
+		}
+	})
+
+	t.Run("Given colored output When copying Then color codes are stripped", func(t *testing.T) {
+		clipboard := &fakeClipboard{}
+
+		copyOutput(clipboard, "[\033[1mTICKET-001\033[0m] Fix login bug\n", &bytes.Buffer{})
+
+		if clipboard.copied != "[TICKET-001] Fix login bug\n" {
+			t.Errorf("Expected plain text, got %q", clipboard.copied)
+		}
+	})
+
+	t.Run("Given no clipboard tool When copying Then a warning is written", func(t *testing.T) {
+		clipboard := &fakeClipboard{err: errors.New("no clipboard utility found")}
+
+		var warnings bytes.Buffer
+		copyOutput(clipboard, "text", &warnings)
+
+		if !strings.Contains(warnings.String(), "Warning: could not copy to clipboard") {
+			t.Errorf("Expected clipboard warning, got %q", warnings.String())
+		}
+	})
+}
@@ -2,11 +2,13 @@ package commands
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
 	"os"
 	"strings"
 
+	"github.com/Germanicus1/fb/api"
 	"github.com/Germanicus1/fb/config"
 	"github.com/Germanicus1/fb/internal/service"
 	"github.com/Germanicus1/fb/internal/state"
@@ -14,13 +16,13 @@ import (
 )
 
 // ExecuteInteractive enters interactive comment mode to add a comment to a ticket
-func ExecuteInteractive(cfg *config.Config, binFilter string) error {
-	return ExecuteInteractiveWithOutput(os.Stdout, binFilter, cfg)
+func ExecuteInteractive(ctx context.Context, svcOpts service.Options, cfg *config.Config, binFilter string) error {
+	return ExecuteInteractiveWithOutput(ctx, svcOpts, os.Stdout, binFilter, cfg)
 }
 
 // ExecuteInteractiveWithOutput enters interactive comment mode with custom output writer (for testing)
-func ExecuteInteractiveWithOutput(output io.Writer, binFilter string, cfg *config.Config) error {
-	ticketService, err := service.NewTicketService(cfg)
+func ExecuteInteractiveWithOutput(ctx context.Context, svcOpts service.Options, output io.Writer, binFilter string, cfg *config.Config) error {
+	ticketService, err := service.NewTicketService(ctx, cfg, svcOpts)
 	if err != nil {
 		return err
 	}
@@ -33,10 +35,11 @@ func ExecuteInteractiveWithOutput(output io.Writer, binFilter string, cfg *confi
 	// Resolve bin filter if provided
 	binID := ""
 	if binFilter != "" {
-		binID, err = service.ResolveBinFilter(ticketService.GetClient(), binFilter)
+		bin, err := service.ResolveBinFilter(ticketService.GetClient(), binFilter)
 		if err != nil {
 			return err
 		}
+		binID = bin.ID
 	}
 
 	// Fetch tickets with optional bin filter
@@ -71,18 +74,25 @@ func ExecuteInteractiveWithOutput(output io.Writer, binFilter string, cfg *confi
 	commentID := service.GenerateCommentID()
 	payload := service.BuildCommentPayload(commentID, selectedTicket.ID, comment)
 
-	err = service.PostComment(ticketService.GetClient(), payload)
+	result, err := ticketService.PostComment(payload)
 	if err != nil {
 		return err
 	}
 
-	displaySuccessConfirmation(output, selectedTicket)
+	displaySuccessConfirmation(output, selectedTicket, result)
+	warnUnconfirmedComment(os.Stderr, result)
 
 	return nil
 }
 
 // ExecuteQuick adds a comment to the checked-out ticket
-func ExecuteQuick(comment string) error {
+func ExecuteQuick(ctx context.Context, svcOpts service.Options, comment string) error {
+	return ExecuteQuickWithConfig(ctx, svcOpts, comment, config.LoadConfig)
+}
+
+// ExecuteQuickWithConfig adds a comment to the checked-out ticket, calling loadConfig only
+// once a checkout is found. This lets the CLI apply runtime options to the configuration.
+func ExecuteQuickWithConfig(ctx context.Context, svcOpts service.Options, comment string, loadConfig func() (*config.Config, error)) error {
 	// Load checkout state
 	checkout, err := state.LoadCheckout()
 	if err != nil {
@@ -90,12 +100,12 @@ func ExecuteQuick(comment string) error {
 	}
 
 	// Post comment via API
-	cfg, err := config.LoadConfig()
+	cfg, err := loadConfig()
 	if err != nil {
 		return err
 	}
 
-	ticketService, err := service.NewTicketService(cfg)
+	ticketService, err := service.NewTicketService(ctx, cfg, svcOpts)
 	if err != nil {
 		return err
 	}
@@ -103,14 +113,68 @@ func ExecuteQuick(comment string) error {
 	commentID := service.GenerateCommentID()
 	payload := service.BuildCommentPayload(commentID, checkout.TicketID, comment)
 
-	if err := service.PostComment(ticketService.GetClient(), payload); err != nil {
+	result, err := ticketService.PostComment(payload)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Comment added to: %s%s\n", checkout.TicketName, commentIDSuffix(result))
+	warnUnconfirmedComment(os.Stderr, result)
+	return nil
+}
+
+// ExecuteComment posts a comment to the ticket with the given ID. A message of "-" reads
+// the comment from stdin, so multi-line text can be piped in.
+func ExecuteComment(ctx context.Context, svcOpts service.Options, ticketID, message string) error {
+	if err := validateTicketID(ticketID); err != nil {
+		return err
+	}
+	comment, err := readCommentBody(message, os.Stdin)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	ticketService, err := service.NewTicketService(ctx, cfg, svcOpts)
+	if err != nil {
 		return err
 	}
 
-	fmt.Printf("✓ Comment added to: %s\n", checkout.TicketName)
+	commentID := service.GenerateCommentID()
+	payload := service.BuildCommentPayload(commentID, ticketID, comment)
+
+	result, err := ticketService.PostComment(payload)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Comment added to: %s%s\n", ticketID, commentIDSuffix(result))
+	warnUnconfirmedComment(os.Stderr, result)
 	return nil
 }
 
+// readCommentBody returns the comment text: message itself, or everything read from stdin
+// when message is "-". Surrounding whitespace is trimmed and a blank comment is an error.
+func readCommentBody(message string, stdin io.Reader) (string, error) {
+	if message == "-" {
+		data, err := io.ReadAll(stdin)
+		if err != nil {
+			return "", fmt.Errorf("failed to read comment from stdin: %w", err)
+		}
+		message = string(data)
+	}
+
+	comment := strings.TrimSpace(message)
+	if comment == "" {
+		return "", fmt.Errorf("comment cannot be empty")
+	}
+	return comment, nil
+}
+
 // displayTicketsForSelection displays a numbered list of tickets for selection
 func displayTicketsForSelection(output io.Writer, tickets []models.Ticket, binFilter string) {
 	if len(tickets) == 0 {
@@ -174,6 +238,24 @@ func enterComment(input io.Reader, output io.Writer) (string, error) {
 }
 
 // displaySuccessConfirmation displays success message after posting a comment
-func displaySuccessConfirmation(output io.Writer, ticket *models.Ticket) {
-	fmt.Fprintf(output, "Comment added successfully to ticket: %s\n", ticket.Name)
+func displaySuccessConfirmation(output io.Writer, ticket *models.Ticket, result *api.CommentResult) {
+	fmt.Fprintf(output, "Comment added successfully to ticket: %s%s\n", ticket.Name, commentIDSuffix(result))
+}
+
+// commentIDSuffix returns " (comment ID)" naming the comment the API created, or an empty
+// string when the response carried no ID
+func commentIDSuffix(result *api.CommentResult) string {
+	if result.ID == "" {
+		return ""
+	}
+	return fmt.Sprintf(" (comment %s)", result.ID)
+}
+
+// warnUnconfirmedComment warns when the API only accepted the comment (202) or answered
+// without content (204), so it may not have been saved yet
+func warnUnconfirmedComment(output io.Writer, result *api.CommentResult) {
+	if result.Confirmed() {
+		return
+	}
+	fmt.Fprintf(output, "Warning: the server answered %d without confirming the comment was saved; check the ticket before posting it again\n", result.StatusCode)
 }
@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/api"
+)
+
+// TestReadCommentBody tests reading the text for fb comment
+//
+// Acceptance Criteria:
+// - The message argument is used as the comment, trimmed
+// - A message of "-" reads the comment from stdin, keeping its line breaks
+// - A blank comment, given directly or on stdin, is an error
+func TestReadCommentBody(t *testing.T) {
+	t.Run("Given a message When reading the comment Then the message is used", func(t *testing.T) {
+		comment, err := readCommentBody("  Ready for review ", strings.NewReader("ignored"))
+
+		if err != nil || comment != "Ready for review" {
+			t.Errorf("Expected the message, got %q (%v)", comment, err)
+		}
+	})
+
+	t.Run("Given - and piped text When reading the comment Then stdin is used", func(t *testing.T) {
+		comment, err := readCommentBody("-", strings.NewReader("First line\nSecond line\n"))
+
+		if err != nil || comment != "First line\nSecond line" {
+			t.Errorf("Expected the piped text, got %q (%v)", comment, err)
+		}
+	})
+
+	t.Run("Given a blank message When reading the comment Then return an error", func(t *testing.T) {
+		if _, err := readCommentBody("   ", strings.NewReader("")); err == nil {
+			t.Error("Expected an error for a blank comment")
+		}
+	})
+
+	t.Run("Given - and blank stdin When reading the comment Then return an error", func(t *testing.T) {
+		if _, err := readCommentBody("-", strings.NewReader("\n\n")); err == nil {
+			t.Error("Expected an error for a blank piped comment")
+		}
+	})
+}
+
+// TestCommentResultReporting tests reporting the API's response to a posted comment
+//
+// Acceptance Criteria:
+// - A comment ID returned by the API is shown after the success message
+// - A 200 or 201 response is confirmed and prints no warning
+// - A 202 or 204 response warns that the comment may not have been saved
+func TestCommentResultReporting(t *testing.T) {
+	t.Run("Given a created comment with an ID When reporting Then the ID is shown without a warning", func(t *testing.T) {
+		result := &api.CommentResult{StatusCode: http.StatusCreated, ID: "c123"}
+		var warnings bytes.Buffer
+
+		suffix := commentIDSuffix(result)
+		warnUnconfirmedComment(&warnings, result)
+
+		if suffix != " (comment c123)" {
+			t.Errorf("Expected the comment ID suffix, got %q", suffix)
+		}
+		if warnings.Len() != 0 {
+			t.Errorf("Expected no warning, got %q", warnings.String())
+		}
+	})
+
+	for _, status := range []int{http.StatusAccepted, http.StatusNoContent} {
+		t.Run("Given an unconfirmed response When reporting Then a warning names the status", func(t *testing.T) {
+			result := &api.CommentResult{StatusCode: status}
+			var warnings bytes.Buffer
+
+			suffix := commentIDSuffix(result)
+			warnUnconfirmedComment(&warnings, result)
+
+			if suffix != "" {
+				t.Errorf("Expected no suffix without an ID, got %q", suffix)
+			}
+			if !strings.Contains(warnings.String(), "Warning") {
+				t.Errorf("Expected a warning for status %d, got %q", status, warnings.String())
+			}
+		})
+	}
+}
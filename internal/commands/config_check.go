@@ -0,0 +1,41 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/Germanicus1/fb/config"
+	"github.com/Germanicus1/fb/internal/service"
+)
+
+// ExecuteConfigCheck verifies an already loaded and validated config against the API by
+// discovering the REST endpoint and looking up the configured user, without fetching tickets
+func ExecuteConfigCheck(ctx context.Context, svcOpts service.Options, cfg *config.Config) error {
+	return checkConfig(ctx, svcOpts, cfg, os.Stdout)
+}
+
+// checkConfig runs the connectivity test and reports the authenticated user on success.
+// The cached REST prefix and user ID are ignored so both are always checked afresh.
+func checkConfig(ctx context.Context, svcOpts service.Options, cfg *config.Config, output io.Writer) error {
+	cfg.RefreshPrefix = true
+	cfg.RefreshUser = true
+
+	ticketService, err := service.NewTicketService(ctx, cfg, svcOpts)
+	if err != nil {
+		return err
+	}
+
+	user, err := ticketService.GetCurrentUser(cfg.UserEmail)
+	if err != nil {
+		return fmt.Errorf("authentication failed for %s: %w", cfg.UserEmail, err)
+	}
+
+	email := user.Email
+	if email == "" {
+		email = cfg.UserEmail
+	}
+	fmt.Fprintf(output, "Config OK, authenticated as %s\n", email)
+	return nil
+}
@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/config"
+	"github.com/Germanicus1/fb/internal/service"
+)
+
+// TestConfigCheck tests `fb config check` against a stub API
+//
+// Acceptance Criteria:
+// - A reachable API and known user print "Config OK, authenticated as <email>"
+// - The REST prefix is discovered even when a cached one exists
+// - A rejected auth key returns an error naming the user, and nothing is printed
+func TestConfigCheck(t *testing.T) {
+	setup := func(t *testing.T, userStatus int) (*config.Config, *int) {
+		t.Setenv("HOME", t.TempDir())
+
+		discoveries := 0
+		var server *httptest.Server
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.HasPrefix(r.URL.Path, "/users/") {
+				w.WriteHeader(userStatus)
+				fmt.Fprint(w, `{"_id": "user-1", "email": "me@example.com", "name": "Me"}`)
+				return
+			}
+			discoveries++
+			fmt.Fprintf(w, `{"restUrlPrefix": "%s"}`, server.URL)
+		}))
+		t.Cleanup(server.Close)
+
+		cfg := &config.Config{AuthKey: "test-key", OrgID: "org-123", UserEmail: "me@example.com", RestDirectoryURL: server.URL}
+		return cfg, &discoveries
+	}
+
+	t.Run("Given a valid config When checking Then the authenticated user is reported", func(t *testing.T) {
+		cfg, discoveries := setup(t, http.StatusOK)
+
+		for i := 0; i < 2; i++ {
+			var output bytes.Buffer
+			if err := checkConfig(context.Background(), service.Options{}, cfg, &output); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if output.String() != "Config OK, authenticated as me@example.com\n" {
+				t.Errorf("Unexpected output %q", output.String())
+			}
+		}
+
+		if *discoveries != 2 {
+			t.Errorf("Expected discovery on every check, got %d", *discoveries)
+		}
+	})
+
+	t.Run("Given a rejected auth key When checking Then an authentication error is returned", func(t *testing.T) {
+		cfg, _ := setup(t, http.StatusUnauthorized)
+
+		var output bytes.Buffer
+		err := checkConfig(context.Background(), service.Options{}, cfg, &output)
+		if err == nil || !strings.Contains(err.Error(), "authentication failed for me@example.com") {
+			t.Errorf("Expected authentication error, got %v", err)
+		}
+		if output.Len() != 0 {
+			t.Errorf("Expected no output on failure, got %q", output.String())
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/Germanicus1/fb/config"
+)
+
+// visibleAuthKeyChars is how many trailing characters of the auth key fb config get shows
+const visibleAuthKeyChars = 4
+
+// ExecuteConfigSet updates one key in ~/.fb/config.yaml, or the fb --config file, creating
+// the file if needed
+func ExecuteConfigSet(key, value string) error {
+	return setConfigValue(key, value, os.Stdout)
+}
+
+// ExecuteConfigGet prints one key from ~/.fb/config.yaml, or the fb --config file, with the
+// auth key redacted
+func ExecuteConfigGet(key string) error {
+	return getConfigValue(key, os.Stdout)
+}
+
+// setConfigValue loads the user config, updates key, and saves it with user-only permissions
+func setConfigValue(key, value string, output io.Writer) error {
+	cfg, err := config.LoadUserConfig()
+	if err != nil {
+		return err
+	}
+	if err := cfg.Set(key, value); err != nil {
+		return err
+	}
+	if err := config.SaveConfig(cfg); err != nil {
+		return err
+	}
+
+	configPath, err := config.UserConfigPath()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(output, "Set %s in %s\n", key, configPath)
+	return nil
+}
+
+// getConfigValue prints key's value from the user config, or nothing when it is unset
+func getConfigValue(key string, output io.Writer) error {
+	cfg, err := config.LoadUserConfig()
+	if err != nil {
+		return err
+	}
+	value, err := cfg.Get(key)
+	if err != nil {
+		return err
+	}
+	if key == "auth_key" {
+		value = redactAuthKey(value)
+	}
+	fmt.Fprintln(output, value)
+	return nil
+}
+
+// redactAuthKey masks all but the last few characters of an auth key, enough to tell keys
+// apart. Keys too short to keep any characters hidden are masked entirely.
+func redactAuthKey(key string) string {
+	if key == "" {
+		return ""
+	}
+	runes := []rune(key)
+	if len(runes) <= visibleAuthKeyChars {
+		return strings.Repeat("*", len(runes))
+	}
+	return strings.Repeat("*", len(runes)-visibleAuthKeyChars) + string(runes[len(runes)-visibleAuthKeyChars:])
+}
@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/config"
+)
+
+// TestConfigSetAndGet tests fb config set and fb config get
+//
+// Acceptance Criteria:
+// - set creates ~/.fb/config.yaml with 0600 permissions and keeps other keys
+// - The saved file loads as a valid config once all required keys are set
+// - get prints the value, with auth_key redacted to its last 4 characters
+// - Unknown keys are rejected
+func TestConfigSetAndGet(t *testing.T) {
+	setAll := func(t *testing.T) string {
+		t.Helper()
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		for _, kv := range [][2]string{{"auth_key", "123:abcdefgh"}, {"org_id", "org"}, {"user_email", "me@example.com"}} {
+			if err := setConfigValue(kv[0], kv[1], &bytes.Buffer{}); err != nil {
+				t.Fatalf("Expected no error setting %s, got %v", kv[0], err)
+			}
+		}
+		return filepath.Join(home, ".fb", "config.yaml")
+	}
+
+	t.Run("Given no config When setting every required key Then a loadable 0600 config is written", func(t *testing.T) {
+		configPath := setAll(t)
+
+		info, err := os.Stat(configPath)
+		if err != nil {
+			t.Fatalf("Expected config file, got %v", err)
+		}
+		if perm := info.Mode().Perm(); perm != 0600 {
+			t.Errorf("Expected 0600 permissions, got %o", perm)
+		}
+		cfg, err := config.LoadConfigAt(configPath)
+		if err != nil {
+			t.Fatalf("Expected the saved config to load, got %v", err)
+		}
+		if cfg.AuthKey != "123:abcdefgh" || cfg.OrgID != "org" || cfg.UserEmail != "me@example.com" {
+			t.Errorf("Expected saved values, got %+v", cfg)
+		}
+	})
+
+	t.Run("Given a saved auth_key When getting it Then only the last 4 characters show", func(t *testing.T) {
+		setAll(t)
+		var output bytes.Buffer
+
+		if err := getConfigValue("auth_key", &output); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if got := output.String(); got != "********efgh\n" {
+			t.Errorf("Expected redacted key, got %q", got)
+		}
+	})
+
+	t.Run("Given a saved org_id When getting it Then it is printed in full", func(t *testing.T) {
+		setAll(t)
+		var output bytes.Buffer
+
+		getConfigValue("org_id", &output)
+
+		if output.String() != "org\n" {
+			t.Errorf("Expected org, got %q", output.String())
+		}
+	})
+
+	t.Run("Given an unknown key When setting Then an error is returned and nothing is written", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+
+		err := setConfigValue("auth_keys", "x", &bytes.Buffer{})
+
+		if err == nil || !strings.Contains(err.Error(), "auth_keys") {
+			t.Errorf("Expected an unknown key error, got %v", err)
+		}
+		if _, statErr := os.Stat(filepath.Join(home, ".fb", "config.yaml")); !os.IsNotExist(statErr) {
+			t.Errorf("Expected no config file to be written")
+		}
+	})
+
+	t.Run("Given short keys When redacting Then nothing is revealed", func(t *testing.T) {
+		if got := redactAuthKey("abcd"); got != "****" {
+			t.Errorf("Expected ****, got %q", got)
+		}
+		if got := redactAuthKey(""); got != "" {
+			t.Errorf("Expected empty, got %q", got)
+		}
+	})
+}
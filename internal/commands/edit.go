@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Germanicus1/fb/config"
+	"github.com/Germanicus1/fb/internal/service"
+	"github.com/Germanicus1/fb/internal/state"
+)
+
+// ExecuteEdit updates the name and/or description of a ticket.
+// A nil name or description leaves that field unchanged. When ticketID is empty,
+// the currently checked-out ticket is edited.
+func ExecuteEdit(ctx context.Context, svcOpts service.Options, ticketID string, name, description *string) error {
+	fields, err := buildTicketUpdateFields(name, description)
+	if err != nil {
+		return err
+	}
+
+	checkout, _ := state.LoadCheckout()
+	if ticketID == "" {
+		if checkout == nil {
+			return fmt.Errorf("no ticket specified and no ticket checked out. Use 'fb edit <ticket-id>' or 'fb checkout' first")
+		}
+		ticketID = checkout.TicketID
+	} else if err := validateTicketID(ticketID); err != nil {
+		return err
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	ticketService, err := service.NewTicketService(ctx, cfg, svcOpts)
+	if err != nil {
+		return err
+	}
+
+	if err := ticketService.UpdateTicket(ticketID, fields); err != nil {
+		return err
+	}
+
+	// Keep the checkout display in sync when the checked-out ticket is renamed
+	if name != nil && checkout != nil && checkout.TicketID == ticketID {
+		checkout.TicketName = *name
+		if err := state.SaveCheckout(checkout); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("✓ Updated ticket: %s\n", ticketID)
+	return nil
+}
+
+// buildTicketUpdateFields builds the update payload from the provided fields.
+// At least one of name or description must be given.
+func buildTicketUpdateFields(name, description *string) (map[string]any, error) {
+	fields := map[string]any{}
+	if name != nil {
+		if *name == "" {
+			return nil, fmt.Errorf("ticket name cannot be empty")
+		}
+		fields["name"] = *name
+	}
+	if description != nil {
+		fields["description"] = *description
+	}
+
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("nothing to update. Use --name and/or --desc")
+	}
+	return fields, nil
+}
@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"testing"
+)
+
+// TestBuildTicketUpdateFields tests validation of the fields passed to fb edit
+func TestBuildTicketUpdateFields(t *testing.T) {
+	name := "New name"
+	desc := "New description"
+	empty := ""
+
+	t.Run("Given no fields When building the update Then return error", func(t *testing.T) {
+		if _, err := buildTicketUpdateFields(nil, nil); err == nil {
+			t.Error("Expected error when no fields are provided")
+		}
+	})
+
+	t.Run("Given only a description When building the update Then only description is included", func(t *testing.T) {
+		fields, err := buildTicketUpdateFields(nil, &desc)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(fields) != 1 || fields["description"] != desc {
+			t.Errorf("Expected only description, got %v", fields)
+		}
+	})
+
+	t.Run("Given name and description When building the update Then both are included", func(t *testing.T) {
+		fields, err := buildTicketUpdateFields(&name, &desc)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(fields) != 2 || fields["name"] != name || fields["description"] != desc {
+			t.Errorf("Expected name and description, got %v", fields)
+		}
+	})
+
+	t.Run("Given an empty name When building the update Then return error", func(t *testing.T) {
+		if _, err := buildTicketUpdateFields(&empty, nil); err == nil {
+			t.Error("Expected error for empty name")
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+)
+
+// formatFiltersFooter describes the filters and options that shaped the list, e.g.
+// "Filters: bin=In Progress, group-by=assignee". It returns an empty string when
+// nothing was applied so the default listing stays unchanged.
+func formatFiltersFooter(opts ListOptions) string {
+	applied := activeFilters(opts)
+	if len(applied) == 0 {
+		return ""
+	}
+	return "\n" + opts.Theme.StyleDim("Filters: "+strings.Join(applied, ", ")) + "\n"
+}
+
+// activeFilters lists the applied filters and options as key=value pairs in a stable order
+func activeFilters(opts ListOptions) []string {
+	var applied []string
+	if opts.BinFilter != "" {
+		applied = append(applied, fmt.Sprintf("bin=%s", opts.BinFilter))
+	}
+	if opts.NoBin {
+		applied = append(applied, "bin=(none)")
+	}
+	if hidesBins(opts) {
+		applied = append(applied, fmt.Sprintf("hidden=%s", strings.Join(opts.HiddenBins, ",")))
+	}
+	if opts.Search != "" {
+		applied = append(applied, fmt.Sprintf("search=%s", opts.Search))
+	}
+	if opts.Overdue {
+		applied = append(applied, "overdue")
+	}
+	if opts.Since != "" {
+		applied = append(applied, fmt.Sprintf("since=%s", opts.Since))
+	}
+	if len(opts.Assignees) > 0 {
+		applied = append(applied, fmt.Sprintf("assignee=%s", strings.Join(opts.Assignees, ",")))
+	}
+	if opts.GroupBy != "" {
+		applied = append(applied, fmt.Sprintf("group-by=%s", opts.GroupBy))
+	}
+	if opts.Sort != "" {
+		sort := opts.Sort
+		if opts.SortDesc {
+			sort += " desc"
+		}
+		applied = append(applied, fmt.Sprintf("sort=%s", sort))
+	}
+	if opts.SortNulls != "" {
+		applied = append(applied, fmt.Sprintf("nulls=%s", opts.SortNulls))
+	}
+	if opts.Limit > 0 {
+		applied = append(applied, fmt.Sprintf("limit=%d", opts.Limit))
+	}
+	if len(opts.Fields) > 0 {
+		applied = append(applied, fmt.Sprintf("fields=%s", strings.Join(opts.Fields, ",")))
+	}
+	if opts.NoEmoji {
+		applied = append(applied, "no-emoji")
+	}
+	return applied
+}
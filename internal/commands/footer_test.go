@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/formatter"
+)
+
+// TestFiltersFooter tests the footer describing applied filters and options
+//
+// Acceptance Criteria:
+// - The footer lists each active filter as key=value
+// - Hidden bins are listed when they are left out
+// - No footer is printed when nothing was applied
+// - With a theme, the footer is dimmed; without one it is plain text
+func TestFiltersFooter(t *testing.T) {
+	t.Run("Given active filters When formatting the footer Then it reflects each of them", func(t *testing.T) {
+		opts := ListOptions{
+			BinFilter: "In Progress",
+			Assignees: []string{"a@x.com", "b@x.com"},
+			GroupBy:   GroupByAssignee,
+			Fields:    []string{"id", "due"},
+		}
+
+		footer := formatFiltersFooter(opts)
+
+		expected := "\nFilters: bin=In Progress, assignee=a@x.com,b@x.com, group-by=assignee, fields=id,due\n"
+		if footer != expected {
+			t.Errorf("Expected %q, got %q", expected, footer)
+		}
+	})
+
+	t.Run("Given sort options When formatting the footer Then direction and nulls placement are shown", func(t *testing.T) {
+		footer := formatFiltersFooter(ListOptions{Sort: "due", SortDesc: true, SortNulls: "first"})
+
+		if !strings.Contains(footer, "Filters: sort=due desc, nulls=first") {
+			t.Errorf("Expected sort details, got %q", footer)
+		}
+	})
+
+	t.Run("Given --no-bin When formatting the footer Then the bin filter shows as none", func(t *testing.T) {
+		footer := formatFiltersFooter(ListOptions{NoBin: true})
+
+		if !strings.Contains(footer, "Filters: bin=(none)") {
+			t.Errorf("Expected bin=(none), got %q", footer)
+		}
+	})
+
+	t.Run("Given hidden bins When formatting the footer Then they are listed unless a bin is selected", func(t *testing.T) {
+		footer := formatFiltersFooter(ListOptions{HiddenBins: []string{"Done", "Archived"}})
+		if !strings.Contains(footer, "Filters: hidden=Done,Archived") {
+			t.Errorf("Expected hidden bins, got %q", footer)
+		}
+
+		footer = formatFiltersFooter(ListOptions{HiddenBins: []string{"Done"}, BinFilter: "Done"})
+		if strings.Contains(footer, "hidden=") {
+			t.Errorf("Expected no hidden bins with --bin, got %q", footer)
+		}
+	})
+
+	t.Run("Given no filters When formatting the footer Then it is empty", func(t *testing.T) {
+		if footer := formatFiltersFooter(ListOptions{Verbose: true}); footer != "" {
+			t.Errorf("Expected no footer, got %q", footer)
+		}
+	})
+
+	t.Run("Given a theme When formatting the footer Then it is dimmed", func(t *testing.T) {
+		theme, err := formatter.BuildTheme(formatter.ThemeDark, nil)
+		if err != nil {
+			t.Fatalf("Failed to build theme: %v", err)
+		}
+
+		footer := formatFiltersFooter(ListOptions{BinFilter: "Done", Theme: theme})
+
+		if !strings.Contains(footer, "\033[2mFilters: bin=Done\033[0m") {
+			t.Errorf("Expected dimmed footer, got %q", footer)
+		}
+	})
+}
@@ -4,89 +4,510 @@
 package commands
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/Germanicus1/fb/api"
 	"github.com/Germanicus1/fb/config"
+	"github.com/Germanicus1/fb/filter"
 	"github.com/Germanicus1/fb/formatter"
 	"github.com/Germanicus1/fb/internal/service"
 	"github.com/Germanicus1/fb/internal/state"
 	"github.com/Germanicus1/fb/models"
 )
 
+// Group-by modes supported by the list command
+const (
+	GroupByAssignee = "assignee"
+	GroupByBin      = "bin"
+)
+
+// Machine-readable output modes supported by the list command
+const (
+	OutputCSV      = "csv"
+	OutputJSON     = "json"      // Tickets wrapped in a {"schema_version", "tickets"} envelope
+	OutputJSONBare = "json-bare" // Tickets as a bare JSON array
+)
+
+// defaultLargeFetchThreshold is the ticket count above which an unfiltered fetch suggests --bin
+const defaultLargeFetchThreshold = 500
+
+// ListOptions controls how the list command fetches and displays tickets
+type ListOptions struct {
+	BinFilter string
+	NoBin     bool // Only list tickets without a bin; cannot be combined with BinFilter
+	SelectBin bool // Prompt for one or more bins to filter by (--bin given without a value)
+	Verbose   bool
+	Assignees []string // Emails of users whose tickets to list; empty means the configured user
+	GroupBy   string   // Empty for a flat list, or one of the GroupBy constants
+	Theme     *formatter.Theme
+	NoEmoji   bool
+	Quiet     bool     // Print only ticket lines: no header, footer, notes, or hints on stderr
+	Output    string   // Empty for human-readable output, or one of the Output constants
+	Table     bool     // Show human-readable output as aligned columns instead of one block or line per ticket
+	ASCII     bool     // Mark the checked-out ticket with an ASCII arrow, for terminals without UTF-8
+	Fields    []string // Fields to show, validated with formatter.ParseFields; empty for the default view
+	Sort      string   // Sort key (one of the formatter.SortBy constants); empty keeps API order
+	SortDesc  bool
+	SortNulls string // Where tickets without a value for the sort key go: formatter.NullsFirst or NullsLast
+	Copy      bool   // Also copy the rendered output to the system clipboard
+	Limit     int    // Show at most this many tickets; zero or negative means unlimited
+	Search    string // Only list tickets whose name or description contains this text
+	Overdue   bool   // Only list tickets whose due date is before today
+	Since     string // Only list tickets updated since this age (24h, 7d) or date (YYYY-MM-DD)
+	Count     bool   // Print only the number of tickets that would be listed
+	Width     int    // Column width descriptions wrap to; zero for the formatter default
+	WithBin   bool   // Append each ticket's bin to minimal lines
+
+	EmptyMessage string   // Custom message for an empty human-readable list; suppressed by Quiet
+	HiddenBins   []string // Bins whose tickets are left out unless the list is filtered to bins
+
+	resolvedBinName string // Name of the bin BinFilter was looked up and confirmed as; set by Execute
+}
+
 // Execute runs the main list command to display tickets
-func Execute(cfg *config.Config, binFilter string, verbose bool) error {
+func Execute(ctx context.Context, svcOpts service.Options, cfg *config.Config, opts ListOptions) error {
+	if err := validateGroupBy(opts.GroupBy); err != nil {
+		return err
+	}
+	if opts.NoBin && opts.BinFilter != "" {
+		return fmt.Errorf("--no-bin cannot be combined with a --bin value")
+	}
+	if err := validateSort(opts); err != nil {
+		return err
+	}
+	if opts.Count && opts.Output != "" {
+		return fmt.Errorf("--count cannot be combined with --csv or --json")
+	}
+	if err := validateTable(opts); err != nil {
+		return err
+	}
+	if err := validateWithBin(opts); err != nil {
+		return err
+	}
+	var since time.Time
+	if opts.Since != "" {
+		var err error
+		if since, err = filter.ParseSince(opts.Since, time.Now()); err != nil {
+			return err
+		}
+	}
+
 	apiStart := time.Now()
 
-	ticketService, err := service.NewTicketService(cfg)
+	ticketService, err := service.NewTicketService(ctx, cfg, svcOpts)
 	if err != nil {
 		return err
 	}
 
-	user, err := ticketService.GetCurrentUser(cfg.UserEmail)
+	assigneeNames, err := resolveAssignees(ticketService, cfg, opts.Assignees)
 	if err != nil {
 		return err
 	}
 
-	// Convert bin filter name to ID if needed
+	// Convert bin filter name to ID if needed. Several comma-separated bins are
+	// filtered locally, since the search endpoint takes a single bin.
 	binID := ""
-	if binFilter != "" {
-		binID, err = service.ResolveBinFilter(ticketService.GetClient(), binFilter)
+	multiBins := splitBinFilter(opts.BinFilter)
+	if len(multiBins) > 1 {
+		var failed string
+		if multiBins, failed, err = resolveBinIDs(ticketService, multiBins); err != nil {
+			printBinCorrection(ticketService, opts, failed, err)
+			return err
+		}
+	} else if opts.BinFilter != "" {
+		var bin models.Bin
+		bin, err = service.ResolveBinFilter(ticketService.GetClient(), opts.BinFilter)
 		if err != nil {
+			printBinCorrection(ticketService, opts, opts.BinFilter, err)
+			return err
+		}
+		binID = bin.ID
+		opts.resolvedBinName = bin.Name // Empty when an unlisted ID was passed through
+	}
+
+	var selectedBins []models.Bin
+	if opts.SelectBin {
+		if selectedBins, err = promptForBins(ticketService); err != nil {
 			return err
 		}
+		opts.BinFilter = binNames(selectedBins)
 	}
 
-	tickets, err := ticketService.GetUserTicketsFiltered(user.ID, binID, "")
+	// Your own tickets are fetched the same way as by fb.FetchAssignedTickets
+	var tickets []models.Ticket
+	if len(opts.Assignees) == 0 {
+		tickets, err = ticketService.FetchAssignedTickets(binID)
+	} else {
+		tickets, err = ticketService.GetTicketsForUsers(mapKeys(assigneeNames), binID)
+	}
 	if err != nil {
 		return err
 	}
+	fetchedCount := len(tickets)
+	tickets = filter.DedupeByID(tickets)
+	if opts.SelectBin {
+		tickets = filterBySelectedBins(tickets, selectedBins)
+	}
+	if len(multiBins) > 1 {
+		tickets = filter.FilterByBinNames(tickets, multiBins)
+	}
+	binMatchedNothing := binID != "" && len(tickets) == 0
 
 	apiDuration := time.Since(apiStart)
 
-	displayTickets(tickets, verbose)
+	// Only the unfiltered list of your own tickets is a valid assigned-ticket count,
+	// and the only one a missing checkout can be judged against
+	allAssigned := len(opts.Assignees) == 0 && opts.BinFilter == "" && !opts.SelectBin
+	staleNote := ""
+	if allAssigned {
+		cacheTicketCount(len(tickets))
+		staleNote = staleCheckoutNote(tickets)
+	}
 
-	if verbose {
+	if hidesBins(opts) {
+		tickets = filter.FilterExcludeBins(tickets, opts.HiddenBins)
+	}
+	if opts.NoBin {
+		tickets = filter.FilterNoBin(tickets)
+	}
+	if opts.Search != "" {
+		tickets = filter.FilterBySearchTerm(tickets, opts.Search)
+	}
+	if opts.Overdue {
+		tickets = filter.FilterOverdue(tickets)
+	}
+	if opts.Since != "" {
+		tickets = filter.FilterUpdatedSince(tickets, since)
+	}
+	if opts.Sort != "" {
+		formatter.SortTicketsWithNulls(tickets, opts.Sort, opts.SortDesc, opts.SortNulls)
+	}
+	if binMatchedNothing && opts.EmptyMessage == "" && opts.Output == "" && !opts.Quiet {
+		opts.EmptyMessage = suggestBin(ticketService, opts.BinFilter)
+	}
+	total := len(tickets)
+	tickets = limitTickets(tickets, opts.Limit)
+
+	output, err := renderTickets(tickets, total, opts, assigneeNames)
+	if err != nil {
+		return err
+	}
+	fmt.Print(output)
+	if opts.Copy {
+		copyOutput(systemClipboard{}, output, os.Stderr)
+	}
+
+	if opts.Count {
+		return nil
+	}
+
+	if opts.Output == "" && !opts.Quiet {
+		fmt.Print(formatFiltersFooter(opts))
+	}
+	if opts.Output == "" && !opts.Quiet {
+		fmt.Print(staleNote)
+	}
+
+	if opts.BinFilter == "" && !opts.NoBin && !opts.Quiet {
+		writeLargeFetchHint(os.Stderr, fetchedCount, resolveLargeFetchThreshold(cfg))
+	}
+
+	if opts.Verbose {
 		fmt.Fprintf(os.Stderr, "API request time: %.3fs\n", apiDuration.Seconds())
 	}
 
 	return nil
 }
 
-// displayTickets formats and displays tickets to stdout
-func displayTickets(tickets []models.Ticket, verbose bool) {
-	output := formatTicketsWithCheckoutIndicator(tickets, verbose)
-	fmt.Print(output)
+// splitBinFilter splits a comma-separated --bin value such as "Doing,In Review" into bin names
+func splitBinFilter(binFilter string) []string {
+	var names []string
+	for _, name := range strings.Split(binFilter, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// resolveBinIDs looks up the ID of each bin name or ID with a single bin fetch, erroring on
+// the first one that matches no bin or several; that value is also returned as failed
+func resolveBinIDs(ticketService *service.TicketService, names []string) (ids []string, failed string, err error) {
+	bins, err := ticketService.GetBins()
+	if err != nil {
+		return nil, "", err
+	}
+
+	ids = make([]string, len(names))
+	for i, name := range names {
+		bin, err := api.FindBin(bins, name)
+		if err != nil {
+			return nil, name, service.BinLookupError(name, err)
+		}
+		ids[i] = bin.ID
+	}
+	return ids, "", nil
+}
+
+// printBinCorrection writes a "Did you mean" suggestion to stderr when err says binFilter
+// matched no bin. A likely typo is still an error, and output meant for scripts gets no prose.
+func printBinCorrection(ticketService *service.TicketService, opts ListOptions, binFilter string, err error) {
+	if !errors.Is(err, api.ErrBinNotFound) || opts.Quiet || opts.Count || opts.Output != "" {
+		return
+	}
+	if suggestion := suggestBin(ticketService, binFilter); suggestion != "" {
+		fmt.Fprintln(os.Stderr, suggestion)
+	}
+}
+
+// maxBinSuggestionDistance is the most edits a bin name may be from a --bin value to be suggested
+const maxBinSuggestionDistance = 2
+
+// suggestBin fetches the bins and returns binSuggestion for a --bin value that matched nothing,
+// or an empty string when the bins cannot be fetched
+func suggestBin(ticketService *service.TicketService, binFilter string) string {
+	bins, err := ticketService.GetBins()
+	if err != nil {
+		return ""
+	}
+	return binSuggestion(bins, binFilter)
+}
+
+// binSuggestion returns a "Did you mean" message naming the bin closest to binFilter, or an
+// empty string when no bin name is close or binFilter names a bin exactly
+func binSuggestion(bins []models.Bin, binFilter string) string {
+	names := make([]string, 0, len(bins))
+	for _, bin := range bins {
+		if bin.ID == binFilter {
+			return ""
+		}
+		names = append(names, bin.Name)
+	}
+
+	suggestion, found := filter.ClosestName(binFilter, names, maxBinSuggestionDistance)
+	if !found {
+		return ""
+	}
+	return fmt.Sprintf("No tickets matched bin '%s'. Did you mean '%s'?", binFilter, suggestion)
+}
+
+// limitTickets returns the first limit tickets, or all of them when limit is zero or negative
+func limitTickets(tickets []models.Ticket, limit int) []models.Ticket {
+	if limit <= 0 || limit >= len(tickets) {
+		return tickets
+	}
+	return tickets[:limit]
+}
+
+// resolveLargeFetchThreshold picks the large-fetch hint threshold from the config or the default
+func resolveLargeFetchThreshold(cfg *config.Config) int {
+	if cfg.LargeFetchThreshold > 0 {
+		return cfg.LargeFetchThreshold
+	}
+	return defaultLargeFetchThreshold
+}
+
+// writeLargeFetchHint suggests filtering by bin when a fetch returned more tickets than the threshold
+func writeLargeFetchHint(output io.Writer, ticketCount, threshold int) {
+	if ticketCount <= threshold {
+		return
+	}
+	fmt.Fprintf(output, "Hint: fetched %d tickets. Use --bin to narrow results for faster queries (--quiet hides this hint).\n", ticketCount)
+}
+
+// validateGroupBy checks that the group-by mode is supported
+func validateGroupBy(groupBy string) error {
+	switch groupBy {
+	case "", GroupByAssignee, GroupByBin:
+		return nil
+	}
+	return fmt.Errorf("unknown --group-by value '%s' (supported: %s, %s)", groupBy, GroupByAssignee, GroupByBin)
+}
+
+// hidesBins reports whether the configured hidden bins are left out. Filtering to bins with
+// --bin shows them, so a hidden bin can still be listed on its own.
+func hidesBins(opts ListOptions) bool {
+	return len(opts.HiddenBins) > 0 && opts.BinFilter == "" && !opts.SelectBin
+}
+
+// validateTable rejects --table with other output layouts
+func validateTable(opts ListOptions) error {
+	if !opts.Table {
+		return nil
+	}
+	if opts.Output != "" {
+		return fmt.Errorf("--table cannot be combined with --csv or --json")
+	}
+	if opts.GroupBy != "" {
+		return fmt.Errorf("--table cannot be combined with --group-by")
+	}
+	return nil
+}
+
+// validateWithBin rejects --with-bin with layouts other than the default minimal list
+func validateWithBin(opts ListOptions) error {
+	if !opts.WithBin {
+		return nil
+	}
+	if opts.Verbose || opts.Table || opts.Output != "" || opts.GroupBy != "" || len(opts.Fields) > 0 {
+		return fmt.Errorf("--with-bin only applies to the minimal list and cannot be combined with --verbose, --table, --csv, --json, --group-by, or --fields")
+	}
+	return nil
+}
+
+// validateSort checks the sort key and nulls placement; --sort-desc and --sort-nulls need --sort
+func validateSort(opts ListOptions) error {
+	if opts.Sort == "" {
+		if opts.SortDesc || opts.SortNulls != "" {
+			return fmt.Errorf("--sort-desc and --sort-nulls require --sort")
+		}
+		return nil
+	}
+	if err := formatter.ValidateSortKey(opts.Sort); err != nil {
+		return err
+	}
+	return formatter.ValidateNullsPlacement(opts.SortNulls)
+}
+
+// resolveAssignees looks up the user for each assignee email and returns a map of user ID to email.
+// With no assignees, the configured user is used.
+func resolveAssignees(ticketService *service.TicketService, cfg *config.Config, assignees []string) (map[string]string, error) {
+	if len(assignees) == 0 {
+		assignees = []string{cfg.UserEmail}
+	}
+
+	names := make(map[string]string, len(assignees))
+	for _, email := range assignees {
+		user, err := ticketService.GetCurrentUser(email)
+		if err != nil {
+			return nil, err
+		}
+		names[user.ID] = email
+	}
+	return names, nil
+}
+
+// mapKeys returns the keys of a string map, sorted so requests built from them are stable
+func mapKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// renderTickets formats tickets as a bare count, CSV, JSON, a table, or a flat or grouped human-readable
+// list according to the options. total is the ticket count before any limit, reported in the
+// flat list header. Only human-readable output gets the checkout indicator.
+func renderTickets(tickets []models.Ticket, total int, opts ListOptions, assigneeNames map[string]string) (string, error) {
+	if opts.Count {
+		return fmt.Sprintf("%d\n", len(tickets)), nil
+	}
+
+	fields := opts.Fields
+	if len(fields) == 0 {
+		fields = formatter.DefaultFields
+	}
+
+	switch opts.Output {
+	case OutputCSV:
+		return formatter.FormatTicketsCSVFields(tickets, fields)
+	case OutputJSON:
+		return formatter.FormatTicketsJSONEnvelope(tickets, fields)
+	case OutputJSONBare:
+		return formatter.FormatTicketsJSON(tickets, fields)
+	}
+
+	if len(tickets) == 0 && opts.Quiet {
+		return "", nil
+	}
+
+	if opts.Table {
+		tableFields := opts.Fields
+		if len(tableFields) == 0 {
+			tableFields = formatter.TableFields
+		}
+		return addCheckoutIndicator(formatter.FormatTicketsTableFields(tickets, tableFields), opts.ASCII), nil
+	}
+	if opts.GroupBy == GroupByAssignee && len(tickets) > 0 {
+		return addCheckoutIndicator(formatter.FormatTicketsGroupedByAssignee(tickets, assigneeNames), opts.ASCII), nil
+	}
+	if opts.GroupBy == GroupByBin && len(tickets) > 0 {
+		return addCheckoutIndicator(formatter.FormatTicketsGroupedByBin(tickets), opts.ASCII), nil
+	}
+	return addCheckoutIndicator(formatter.FormatTicketsWithOptions(tickets, formatter.Options{
+		Verbose: opts.Verbose,
+		Theme:   opts.Theme,
+		NoEmoji: opts.NoEmoji,
+		Fields:  opts.Fields,
+		Width:   opts.Width,
+		Quiet:   opts.Quiet,
+		WithBin: opts.WithBin,
+
+		EmptyMessage: opts.EmptyMessage,
+		BinName:      opts.resolvedBinName,
+		Total:        total,
+	}), opts.ASCII), nil
 }
 
 // formatTicketsWithCheckoutIndicator formats tickets and adds indicator for checked-out ticket
 func formatTicketsWithCheckoutIndicator(tickets []models.Ticket, verbose bool) string {
+	return addCheckoutIndicator(formatTicketsWithVerbosity(tickets, verbose), false)
+}
+
+// Markers appended to the line of the checked-out ticket; the ASCII form is for terminals
+// that cannot render the arrow
+const (
+	checkoutIndicator      = " ← CHECKED OUT"
+	asciiCheckoutIndicator = " <-- CHECKED OUT"
+)
+
+// addCheckoutIndicator marks the lines of formatted output that contain the checked-out ticket ID,
+// with the ASCII marker when ascii is set
+func addCheckoutIndicator(output string, ascii bool) string {
 	// Load current checkout state
 	checkoutState, err := state.LoadCheckout()
-	if err != nil {
-		// No checkout or error loading - just format normally
-		return formatTicketsWithVerbosity(tickets, verbose)
+	if err != nil || checkoutState == nil {
+		// No checkout or error loading - leave output unchanged
+		return output
 	}
 
-	// Format tickets based on verbosity
-	output := formatTicketsWithVerbosity(tickets, verbose)
-
-	// Add indicator to checked-out ticket
-	if checkoutState != nil {
-		// Find lines containing the checked-out ticket ID
-		lines := strings.Split(output, "\n")
-		for i, line := range lines {
-			if strings.Contains(line, checkoutState.TicketID) {
-				// Add indicator to this line
-				lines[i] = line + " ← CHECKED OUT"
+	// Find lines containing the checked-out ticket ID
+	lines := strings.Split(output, "\n")
+	for i, line := range lines {
+		if strings.Contains(line, checkoutState.TicketID) {
+			// Add indicator to this line
+			if ascii {
+				lines[i] = line + asciiCheckoutIndicator
+			} else {
+				lines[i] = line + checkoutIndicator
 			}
 		}
-		output = strings.Join(lines, "\n")
 	}
+	return strings.Join(lines, "\n")
+}
 
-	return output
+// staleCheckoutNote returns a note when a ticket is checked out but is not among tickets,
+// the full list of assigned tickets, or an empty string otherwise
+func staleCheckoutNote(tickets []models.Ticket) string {
+	checkoutState, err := state.LoadCheckout()
+	if err != nil || checkoutState == nil {
+		return ""
+	}
+	for _, ticket := range tickets {
+		if ticket.ID == checkoutState.TicketID {
+			return ""
+		}
+	}
+	return fmt.Sprintf("Note: your checked-out ticket %s is no longer in your assigned tickets.\n", checkoutState.TicketID)
 }
 
 // formatTicketsWithVerbosity formats tickets using minimal or verbose mode
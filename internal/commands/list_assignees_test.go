@@ -0,0 +1,22 @@
+package commands
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestMapKeys tests building the user list for the ticket search
+//
+// Acceptance Criteria:
+// - The user IDs are returned sorted, so the users= query is the same on every run
+func TestMapKeys(t *testing.T) {
+	t.Run("Given several assignees When collecting their IDs Then they are sorted", func(t *testing.T) {
+		assignees := map[string]string{"id-c": "Carol", "id-a": "Alice", "id-b": "Bob", "id-d": "Dan"}
+
+		for i := 0; i < 10; i++ {
+			if keys := mapKeys(assignees); !slices.Equal(keys, []string{"id-a", "id-b", "id-c", "id-d"}) {
+				t.Fatalf("Expected sorted IDs, got %v", keys)
+			}
+		}
+	})
+}
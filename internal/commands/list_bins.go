@@ -1,7 +1,10 @@
 package commands
 
 import (
+	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/Germanicus1/fb/config"
 	"github.com/Germanicus1/fb/internal/service"
@@ -9,8 +12,8 @@ import (
 )
 
 // ExecuteListBins lists all available bins
-func ExecuteListBins(cfg *config.Config) error {
-	ticketService, err := service.NewTicketService(cfg)
+func ExecuteListBins(ctx context.Context, svcOpts service.Options, cfg *config.Config) error {
+	ticketService, err := service.NewTicketService(ctx, cfg, svcOpts)
 	if err != nil {
 		return err
 	}
@@ -37,3 +40,38 @@ func formatBinList(bins []models.Bin) string {
 	}
 	return output
 }
+
+// ExecuteBins prints each bin's name and ID, one per line and sorted by name, ready to
+// copy into --bin
+func ExecuteBins(ctx context.Context, svcOpts service.Options, cfg *config.Config) error {
+	ticketService, err := service.NewTicketService(ctx, cfg, svcOpts)
+	if err != nil {
+		return err
+	}
+
+	bins, err := ticketService.GetBins()
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(formatBinLines(bins))
+	return nil
+}
+
+// formatBinLines formats bins as "name<TAB>id" lines sorted case-insensitively by name
+func formatBinLines(bins []models.Bin) string {
+	if len(bins) == 0 {
+		return "No bins found.\n"
+	}
+
+	sorted := append([]models.Bin{}, bins...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return strings.ToLower(sorted[i].Name) < strings.ToLower(sorted[j].Name)
+	})
+
+	var builder strings.Builder
+	for _, bin := range sorted {
+		builder.WriteString(fmt.Sprintf("%s\t%s\n", bin.Name, bin.ID))
+	}
+	return builder.String()
+}
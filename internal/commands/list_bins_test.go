@@ -83,3 +83,32 @@ func TestListBinsCommand(t *testing.T) {
 		}
 	})
 }
+
+// TestBinsCommand tests the output of `fb bins`
+//
+// Acceptance Criteria:
+// - Each bin is printed on its own line as name and ID
+// - Bins are sorted alphabetically by name, ignoring case
+// - An empty list prints "No bins found."
+func TestBinsCommand(t *testing.T) {
+	t.Run("Given unsorted bins When formatting Then print name and ID lines sorted by name", func(t *testing.T) {
+		bins := []models.Bin{
+			{ID: "bin3", Name: "Done"},
+			{ID: "bin1", Name: "in Progress"},
+			{ID: "bin2", Name: "Backlog"},
+		}
+
+		output := formatBinLines(bins)
+
+		expected := "Backlog\tbin2\nDone\tbin3\nin Progress\tbin1\n"
+		if output != expected {
+			t.Errorf("Expected %q, got %q", expected, output)
+		}
+	})
+
+	t.Run("Given no bins When formatting Then print a friendly message", func(t *testing.T) {
+		if output := formatBinLines(nil); output != "No bins found.\n" {
+			t.Errorf("Expected %q, got %q", "No bins found.\n", output)
+		}
+	})
+}
@@ -1,7 +1,10 @@
 package commands
 
 import (
+	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/Germanicus1/fb/config"
 	"github.com/Germanicus1/fb/internal/service"
@@ -9,8 +12,8 @@ import (
 )
 
 // ExecuteListBoards lists all available boards
-func ExecuteListBoards(cfg *config.Config) error {
-	ticketService, err := service.NewTicketService(cfg)
+func ExecuteListBoards(ctx context.Context, svcOpts service.Options, cfg *config.Config) error {
+	ticketService, err := service.NewTicketService(ctx, cfg, svcOpts)
 	if err != nil {
 		return err
 	}
@@ -37,3 +40,45 @@ func formatBoardList(boards []models.Board) string {
 	}
 	return output
 }
+
+// ExecuteBoards prints each board's name, ID, and number of bins, one per line and sorted by name
+func ExecuteBoards(ctx context.Context, svcOpts service.Options, cfg *config.Config) error {
+	ticketService, err := service.NewTicketService(ctx, cfg, svcOpts)
+	if err != nil {
+		return err
+	}
+
+	boards, err := ticketService.GetBoards()
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(formatBoardLines(boards))
+	return nil
+}
+
+// formatBoardLines formats boards as "name<TAB>id<TAB>(N bins)" lines sorted case-insensitively by name
+func formatBoardLines(boards []models.Board) string {
+	if len(boards) == 0 {
+		return "No boards found.\n"
+	}
+
+	sorted := append([]models.Board{}, boards...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return strings.ToLower(sorted[i].Name) < strings.ToLower(sorted[j].Name)
+	})
+
+	var builder strings.Builder
+	for _, board := range sorted {
+		builder.WriteString(fmt.Sprintf("%s\t%s\t(%s)\n", board.Name, board.ID, binCountLabel(len(board.Bins))))
+	}
+	return builder.String()
+}
+
+// binCountLabel returns "1 bin" or "N bins"
+func binCountLabel(count int) string {
+	if count == 1 {
+		return "1 bin"
+	}
+	return fmt.Sprintf("%d bins", count)
+}
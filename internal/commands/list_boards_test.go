@@ -83,3 +83,33 @@ func TestListBoardsCommand(t *testing.T) {
 		}
 	})
 }
+
+// TestBoardsCommand tests the output of `fb boards`
+//
+// Acceptance Criteria:
+// - Each board is printed on its own line with its name, ID, and bin count
+// - Boards are sorted alphabetically by name, ignoring case
+// - A board without bins shows "(0 bins)"
+// - An empty list prints "No boards found."
+func TestBoardsCommand(t *testing.T) {
+	t.Run("Given unsorted boards When formatting Then print name, ID, and bin count sorted by name", func(t *testing.T) {
+		boards := []models.Board{
+			{ID: "board2", Name: "sprint", Bins: []string{"todo", "doing", "done"}},
+			{ID: "board1", Name: "Roadmap", Bins: []string{"idea"}},
+			{ID: "board3", Name: "Archive"},
+		}
+
+		output := formatBoardLines(boards)
+
+		expected := "Archive\tboard3\t(0 bins)\nRoadmap\tboard1\t(1 bin)\nsprint\tboard2\t(3 bins)\n"
+		if output != expected {
+			t.Errorf("Expected %q, got %q", expected, output)
+		}
+	})
+
+	t.Run("Given no boards When formatting Then print a friendly message", func(t *testing.T) {
+		if output := formatBoardLines(nil); output != "No boards found.\n" {
+			t.Errorf("Expected %q, got %q", "No boards found.\n", output)
+		}
+	})
+}
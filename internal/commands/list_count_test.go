@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Germanicus1/fb/internal/service"
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestCountOutput tests the --count output mode
+//
+// Acceptance Criteria:
+// - Only the number of tickets is printed, with a trailing newline
+// - An empty list prints "0"
+// - --count cannot be combined with CSV or JSON output
+func TestCountOutput(t *testing.T) {
+	t.Run("Given filtered tickets When rendering with --count Then print only the number", func(t *testing.T) {
+		tickets := []models.Ticket{{ID: "T1", Name: "One"}, {ID: "T2", Name: "Two"}}
+
+		output, err := renderTickets(tickets, len(tickets), ListOptions{Count: true, BinFilter: "Doing"}, nil)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if output != "2\n" {
+			t.Errorf("Expected %q, got %q", "2\n", output)
+		}
+	})
+
+	t.Run("Given no tickets When rendering with --count Then print 0", func(t *testing.T) {
+		output, _ := renderTickets(nil, 0, ListOptions{Count: true}, nil)
+
+		if output != "0\n" {
+			t.Errorf("Expected %q, got %q", "0\n", output)
+		}
+	})
+
+	t.Run("Given --count and --json When listing Then return an error before fetching", func(t *testing.T) {
+		err := Execute(context.Background(), service.Options{}, nil, ListOptions{Count: true, Output: OutputJSON})
+
+		if err == nil {
+			t.Error("Expected an error for --count with --json")
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestEmptyMessageOverride tests the --empty-message override for an empty ticket list
+//
+// Acceptance Criteria:
+// - A custom empty message replaces "No tickets assigned to you."
+// - Custom, default, and bin-specific messages all end without a trailing newline
+// - --quiet suppresses the empty message entirely
+// - JSON and CSV still emit empty structures; JSON keeps its schema_version envelope
+// - Only a bin confirmed by lookup is named in the empty message, never the raw --bin value
+func TestEmptyMessageOverride(t *testing.T) {
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	var noTickets []models.Ticket
+
+	t.Run("Given a custom empty message When no tickets are found Then the custom message is shown", func(t *testing.T) {
+		output, err := renderTickets(noTickets, 0, ListOptions{EmptyMessage: "All clear! 🎉"}, nil)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if output != "All clear! 🎉" {
+			t.Errorf("Expected custom message, got %q", output)
+		}
+	})
+
+	t.Run("Given a custom empty message and grouping When no tickets are found Then the custom message is shown", func(t *testing.T) {
+		output, err := renderTickets(noTickets, 0, ListOptions{EmptyMessage: "All clear!", GroupBy: GroupByAssignee}, nil)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if output != "All clear!" {
+			t.Errorf("Expected custom message, got %q", output)
+		}
+	})
+
+	t.Run("Given no custom message When no tickets are found Then the default message is shown", func(t *testing.T) {
+		output, err := renderTickets(noTickets, 0, ListOptions{}, nil)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if output != "No tickets assigned to you." {
+			t.Errorf("Expected default message, got %q", output)
+		}
+	})
+
+	t.Run("Given a --bin value that was not confirmed When no tickets are found Then no bin is named", func(t *testing.T) {
+		output, err := renderTickets(noTickets, 0, ListOptions{BinFilter: "Doign"}, nil)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if output != "No tickets assigned to you." {
+			t.Errorf("Expected default message, got %q", output)
+		}
+	})
+
+	t.Run("Given a confirmed bin When no tickets are found Then the bin's name is shown", func(t *testing.T) {
+		output, err := renderTickets(noTickets, 0, ListOptions{BinFilter: "doing", resolvedBinName: "Doing"}, nil)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if output != "No tickets assigned to you in bin 'Doing'." {
+			t.Errorf("Expected the bin message, got %q", output)
+		}
+	})
+
+	t.Run("Given --quiet When no tickets are found Then nothing is printed", func(t *testing.T) {
+		output, err := renderTickets(noTickets, 0, ListOptions{EmptyMessage: "All clear!", Quiet: true}, nil)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if output != "" {
+			t.Errorf("Expected no output, got %q", output)
+		}
+	})
+
+	t.Run("Given JSON or CSV output When no tickets are found Then empty structures are emitted", func(t *testing.T) {
+		jsonOutput, err := renderTickets(noTickets, 0, ListOptions{Output: OutputJSON, EmptyMessage: "All clear!"}, nil)
+		if err != nil || !strings.Contains(jsonOutput, `"tickets": []`) {
+			t.Errorf("Expected an envelope with an empty tickets array, got %q (err %v)", jsonOutput, err)
+		}
+
+		bareOutput, err := renderTickets(noTickets, 0, ListOptions{Output: OutputJSONBare, EmptyMessage: "All clear!"}, nil)
+		if err != nil || bareOutput != "[]\n" {
+			t.Errorf("Expected empty JSON array, got %q (err %v)", bareOutput, err)
+		}
+
+		csvOutput, err := renderTickets(noTickets, 0, ListOptions{Output: OutputCSV, Fields: []string{"id", "name"}, Quiet: true}, nil)
+		if err != nil || csvOutput != "id,name\n" {
+			t.Errorf("Expected CSV header only, got %q (err %v)", csvOutput, err)
+		}
+	})
+}
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/config"
+)
+
+// TestLargeFetchHint tests the stderr hint shown when an unfiltered fetch returns many tickets
+//
+// Acceptance Criteria:
+// - A fetch above the threshold prints a hint suggesting --bin
+// - A fetch at or below the threshold prints nothing
+// - The threshold comes from large_fetch_threshold, defaulting to 500
+func TestLargeFetchHint(t *testing.T) {
+	t.Run("Given more tickets than the threshold When writing the hint Then --bin is suggested", func(t *testing.T) {
+		var output bytes.Buffer
+		writeLargeFetchHint(&output, 501, 500)
+
+		if !strings.Contains(output.String(), "fetched 501 tickets") {
+			t.Errorf("Expected hint with ticket count, got %q", output.String())
+		}
+		if !strings.Contains(output.String(), "--bin") {
+			t.Errorf("Expected hint to suggest --bin, got %q", output.String())
+		}
+	})
+
+	t.Run("Given tickets at or below the threshold When writing the hint Then nothing is printed", func(t *testing.T) {
+		for _, count := range []int{0, 499, 500} {
+			var output bytes.Buffer
+			writeLargeFetchHint(&output, count, 500)
+
+			if output.Len() != 0 {
+				t.Errorf("Expected no hint for %d tickets, got %q", count, output.String())
+			}
+		}
+	})
+
+	t.Run("Given no configured threshold When resolving Then the default is used", func(t *testing.T) {
+		if got := resolveLargeFetchThreshold(&config.Config{}); got != defaultLargeFetchThreshold {
+			t.Errorf("Expected default threshold %d, got %d", defaultLargeFetchThreshold, got)
+		}
+	})
+
+	t.Run("Given a configured threshold When resolving Then the configured value is used", func(t *testing.T) {
+		if got := resolveLargeFetchThreshold(&config.Config{LargeFetchThreshold: 100}); got != 100 {
+			t.Errorf("Expected threshold 100, got %d", got)
+		}
+	})
+}
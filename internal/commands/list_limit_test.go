@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestLimitTickets tests capping the list with --limit
+//
+// Acceptance Criteria:
+// - A positive limit keeps only the first N tickets, in order
+// - Zero or a negative limit means unlimited
+// - A limit larger than the list behaves as if no limit was given
+func TestLimitTickets(t *testing.T) {
+	tickets := []models.Ticket{{ID: "1"}, {ID: "2"}, {ID: "3"}}
+
+	t.Run("Given a limit below the list size When limiting Then keep the first N tickets", func(t *testing.T) {
+		limited := limitTickets(tickets, 2)
+
+		if len(limited) != 2 || limited[0].ID != "1" || limited[1].ID != "2" {
+			t.Errorf("Expected tickets 1 and 2, got %v", limited)
+		}
+	})
+
+	t.Run("Given zero or a negative limit When limiting Then keep every ticket", func(t *testing.T) {
+		for _, limit := range []int{0, -5} {
+			if limited := limitTickets(tickets, limit); len(limited) != 3 {
+				t.Errorf("Expected 3 tickets for limit %d, got %d", limit, len(limited))
+			}
+		}
+	})
+
+	t.Run("Given a limit above the list size When limiting Then keep every ticket", func(t *testing.T) {
+		if limited := limitTickets(tickets, 10); len(limited) != 3 {
+			t.Errorf("Expected 3 tickets, got %d", len(limited))
+		}
+	})
+
+	t.Run("Given a limited list When rendering Then the header reports the real total", func(t *testing.T) {
+		output, err := renderTickets(limitTickets(tickets, 1), len(tickets), ListOptions{}, nil)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if want := "Found 1 of 3 ticket(s) assigned to you:"; !strings.Contains(output, want) {
+			t.Errorf("Expected %q, got:\n%s", want, output)
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestSplitBinFilter tests splitting a comma-separated --bin value
+//
+// Acceptance Criteria:
+// - "Doing,In Review" selects both bins
+// - Whitespace around names and empty entries are ignored
+// - A single bin name is returned on its own
+func TestSplitBinFilter(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected []string
+	}{
+		{"Doing,In Review", []string{"Doing", "In Review"}},
+		{" Doing , In Review ,", []string{"Doing", "In Review"}},
+		{"In Progress", []string{"In Progress"}},
+		{"", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run("Given --bin "+tt.value+" When splitting Then each bin name is returned", func(t *testing.T) {
+			names := splitBinFilter(tt.value)
+
+			if strings.Join(names, "|") != strings.Join(tt.expected, "|") {
+				t.Errorf("Expected %q, got %q", tt.expected, names)
+			}
+		})
+	}
+}
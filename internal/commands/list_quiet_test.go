@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestQuietOutput tests the --quiet output mode used by scripts
+//
+// Acceptance Criteria:
+// - Only the "[ID] Name" lines are printed, without the "Found N" header or its blank line
+// - An empty list prints nothing at all, even with a custom empty message
+func TestQuietOutput(t *testing.T) {
+	t.Run("Given tickets When rendering with --quiet Then print only the ticket lines", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		tickets := []models.Ticket{{ID: "T1", Name: "One"}, {ID: "T2", Name: "Two"}}
+
+		output, err := renderTickets(tickets, len(tickets), ListOptions{Quiet: true}, nil)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if output != "[T1] One\n[T2] Two\n" {
+			t.Errorf("Expected only ticket lines, got %q", output)
+		}
+	})
+
+	t.Run("Given no tickets When rendering with --quiet Then print zero bytes", func(t *testing.T) {
+		output, _ := renderTickets(nil, 0, ListOptions{Quiet: true, EmptyMessage: "All clear!"}, nil)
+
+		if output != "" {
+			t.Errorf("Expected no output, got %q", output)
+		}
+	})
+}
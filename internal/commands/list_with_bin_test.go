@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/internal/service"
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestListWithBin tests the --with-bin list option
+//
+// Acceptance Criteria:
+// - The minimal list shows each ticket's bin in parens
+// - --with-bin cannot be combined with other layouts or --fields
+func TestListWithBin(t *testing.T) {
+	t.Run("Given --with-bin When rendering the minimal list Then bins are shown", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		tickets := []models.Ticket{{ID: "T1", Name: "One", BinName: "Doing"}}
+
+		output, err := renderTickets(tickets, len(tickets), ListOptions{WithBin: true}, nil)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !strings.Contains(output, "[T1] One (Doing)\n") {
+			t.Errorf("Expected bin in parens, got %q", output)
+		}
+	})
+
+	conflicts := map[string]ListOptions{
+		"--verbose":  {WithBin: true, Verbose: true},
+		"--table":    {WithBin: true, Table: true},
+		"--json":     {WithBin: true, Output: OutputJSON},
+		"--group-by": {WithBin: true, GroupBy: GroupByBin},
+		"--fields":   {WithBin: true, Fields: []string{"id"}},
+	}
+	for flag, opts := range conflicts {
+		t.Run("Given --with-bin and "+flag+" When listing Then return an error before fetching", func(t *testing.T) {
+			err := Execute(context.Background(), service.Options{}, nil, opts)
+
+			if err == nil || !strings.Contains(err.Error(), "--with-bin") {
+				t.Errorf("Expected a --with-bin error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Germanicus1/fb/api"
+	"github.com/Germanicus1/fb/config"
+	"github.com/Germanicus1/fb/internal/service"
+	"github.com/Germanicus1/fb/internal/state"
+	"github.com/Germanicus1/fb/models"
+)
+
+// ExecuteMove moves a ticket to the bin named or identified by binQuery, then posts
+// comment on it when one is given. The bin is checked against the bin list before the move.
+func ExecuteMove(ctx context.Context, svcOpts service.Options, cfg *config.Config, ticketID, binQuery, comment string) error {
+	if err := validateTicketID(ticketID); err != nil {
+		return err
+	}
+
+	ticketService, err := service.NewTicketService(ctx, cfg, svcOpts)
+	if err != nil {
+		return err
+	}
+
+	bins, err := ticketService.GetBins()
+	if err != nil {
+		return err
+	}
+
+	target, err := findMoveTarget(bins, binQuery)
+	if err != nil {
+		return err
+	}
+
+	if err := ticketService.MoveTicket(ticketID, target.ID); err != nil {
+		return err
+	}
+	syncCheckoutBin(ticketID, target)
+	fmt.Printf("✓ Moved %s to '%s'\n", ticketID, target.Name)
+
+	if comment == "" {
+		return nil
+	}
+	payload := service.BuildCommentPayload(service.GenerateCommentID(), ticketID, comment)
+	result, err := ticketService.PostComment(payload)
+	if err != nil {
+		return fmt.Errorf("ticket moved, but %w", err)
+	}
+	fmt.Printf("✓ Comment posted to %s%s\n", ticketID, commentIDSuffix(result))
+	warnUnconfirmedComment(os.Stderr, result)
+	return nil
+}
+
+// findMoveTarget returns the bin matching query by exact ID or case-insensitive name,
+// or an error listing the available bins when none matches
+func findMoveTarget(bins []models.Bin, query string) (models.Bin, error) {
+	if strings.TrimSpace(query) == "" {
+		return models.Bin{}, fmt.Errorf("target bin cannot be empty")
+	}
+	bin, err := api.FindBin(bins, query)
+	if !errors.Is(err, api.ErrBinNotFound) {
+		return bin, err
+	}
+
+	names := make([]string, len(bins))
+	for i, bin := range bins {
+		names[i] = bin.Name
+	}
+	return models.Bin{}, fmt.Errorf("bin '%s' not found (available: %s)", query, strings.Join(names, ", "))
+}
+
+// syncCheckoutBin updates the saved checkout when the moved ticket is the checked-out one,
+// so fb status shows its new bin
+func syncCheckoutBin(ticketID string, bin models.Bin) {
+	checkout, err := state.LoadCheckout()
+	if err != nil || checkout.TicketID != ticketID {
+		return
+	}
+	checkout.BinID = bin.ID
+	checkout.BinName = bin.Name
+	state.SaveCheckout(checkout)
+}
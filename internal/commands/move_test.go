@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/api"
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestFindMoveTarget tests resolving the target bin of fb move
+//
+// Acceptance Criteria:
+// - A bin ID matches exactly, and a bin name matches case-insensitively
+// - An unknown bin is an error that names it and lists the available bins
+// - A name shared by several bins is ambiguous rather than silently picking one
+// - An empty target is rejected
+func TestFindMoveTarget(t *testing.T) {
+	bins := []models.Bin{
+		{ID: "binTodo", Name: "To Do"},
+		{ID: "binReview", Name: "In Review"},
+	}
+
+	t.Run("Given a bin ID When resolving Then that bin is returned", func(t *testing.T) {
+		bin, err := findMoveTarget(bins, "binReview")
+		if err != nil || bin.Name != "In Review" {
+			t.Errorf("Expected In Review, got %+v, %v", bin, err)
+		}
+	})
+
+	t.Run("Given a bin name in another case When resolving Then that bin is returned", func(t *testing.T) {
+		bin, err := findMoveTarget(bins, "in review")
+		if err != nil || bin.ID != "binReview" {
+			t.Errorf("Expected binReview, got %+v, %v", bin, err)
+		}
+	})
+
+	t.Run("Given an unknown bin When resolving Then the error lists the available bins", func(t *testing.T) {
+		_, err := findMoveTarget(bins, "Doing")
+		if err == nil || !strings.Contains(err.Error(), "bin 'Doing' not found") || !strings.Contains(err.Error(), "To Do, In Review") {
+			t.Errorf("Expected not-found error listing bins, got %v", err)
+		}
+	})
+
+	t.Run("Given two bins sharing a name When resolving it Then ErrAmbiguousBinName is returned", func(t *testing.T) {
+		dupes := append(bins, models.Bin{ID: "binReview2", Name: "in review"})
+		if _, err := findMoveTarget(dupes, "In Review"); !errors.Is(err, api.ErrAmbiguousBinName) {
+			t.Errorf("Expected ErrAmbiguousBinName, got %v", err)
+		}
+	})
+
+	t.Run("Given an empty target When resolving Then an error is returned", func(t *testing.T) {
+		if _, err := findMoveTarget(bins, " "); err == nil {
+			t.Error("Expected an error for an empty target")
+		}
+	})
+}
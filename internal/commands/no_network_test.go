@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Germanicus1/fb/config"
+	"github.com/Germanicus1/fb/internal/service"
+	"github.com/Germanicus1/fb/internal/state"
+)
+
+// TestNoNetworkCommands tests that --no-network stops data commands but not local ones
+//
+// Acceptance Criteria:
+// - A data command fails fast with "network disabled by --no-network"
+// - Local commands such as status keep working
+func TestNoNetworkCommands(t *testing.T) {
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	checkout := &state.CheckoutState{
+		TicketID:     "TICKET-001",
+		TicketName:   "Fix login bug",
+		BinName:      "Doing",
+		CheckedOutAt: time.Now().Add(-time.Hour).Format(time.RFC3339),
+	}
+	if err := state.SaveCheckout(checkout); err != nil {
+		t.Fatalf("Failed to save checkout: %v", err)
+	}
+
+	cfg := &config.Config{AuthKey: "test-key", OrgID: "org-123", UserEmail: "me@example.com", NoNetwork: true}
+
+	t.Run("Given --no-network When posting a quick comment Then it fails fast", func(t *testing.T) {
+		start := time.Now()
+		err := ExecuteQuickWithConfig(context.Background(), service.Options{}, "Started work", func() (*config.Config, error) { return cfg, nil })
+
+		if err == nil || !strings.Contains(err.Error(), "network disabled by --no-network") {
+			t.Errorf("Expected network disabled error, got %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("Expected to fail fast, took %s", elapsed)
+		}
+	})
+
+	t.Run("Given --no-network When showing status Then the local checkout is still reported", func(t *testing.T) {
+		var output bytes.Buffer
+		if err := writeCheckoutStatus(&output); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !strings.Contains(output.String(), "TICKET-001") {
+			t.Errorf("Expected checkout details, got:\n%s", output.String())
+		}
+	})
+}
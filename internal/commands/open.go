@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/Germanicus1/fb/config"
+	"github.com/Germanicus1/fb/internal/service"
+)
+
+// Browser opens a URL in a web browser
+type Browser interface {
+	Open(url string) error
+}
+
+// systemBrowser opens URLs with the platform's opener
+type systemBrowser struct{}
+
+// browserCommands lists the command used to open a URL on each platform; the URL is appended
+var browserCommands = map[string][]string{
+	"darwin":  {"open"},
+	"linux":   {"xdg-open"},
+	"windows": {"rundll32", "url.dll,FileProtocolHandler"},
+}
+
+// Open launches the platform opener for url
+func (systemBrowser) Open(url string) error {
+	command, ok := browserCommands[runtime.GOOS]
+	if !ok {
+		return fmt.Errorf("opening a browser is not supported on %s", runtime.GOOS)
+	}
+	path, err := exec.LookPath(command[0])
+	if err != nil {
+		return fmt.Errorf("%s not found", command[0])
+	}
+	if err := exec.Command(path, append(command[1:], url)...).Run(); err != nil {
+		return fmt.Errorf("%s failed: %w", command[0], err)
+	}
+	return nil
+}
+
+// ExecuteOpen prints the web URL of a ticket and opens it in the browser unless printOnly.
+// With web_base_url configured no API call is made; otherwise the URL is derived from the
+// discovered REST prefix.
+func ExecuteOpen(ctx context.Context, svcOpts service.Options, cfg *config.Config, ticketID string, printOnly bool) error {
+	if err := validateTicketID(ticketID); err != nil {
+		return err
+	}
+
+	restPrefix := ""
+	if cfg.WebBaseURL == "" {
+		ticketService, err := service.NewTicketService(ctx, cfg, svcOpts)
+		if err != nil {
+			return err
+		}
+		restPrefix = ticketService.GetClient().RestPrefix()
+	}
+
+	ticketURL, err := ticketWebURL(cfg.WebBaseURL, restPrefix, cfg.OrgID, ticketID)
+	if err != nil {
+		return err
+	}
+	return openTicketURL(systemBrowser{}, ticketURL, os.Stdout, printOnly)
+}
+
+// ticketWebURL builds the Flow Boards web URL of a ticket. A configured web base URL is
+// used as is; otherwise the web app is assumed to live on the REST prefix's host, under the org.
+func ticketWebURL(webBaseURL, restPrefix, orgID, ticketID string) (string, error) {
+	if webBaseURL != "" {
+		return fmt.Sprintf("%s/tickets/%s", strings.TrimRight(webBaseURL, "/"), url.PathEscape(ticketID)), nil
+	}
+
+	prefix, err := url.Parse(restPrefix)
+	if err != nil || prefix.Scheme == "" || prefix.Host == "" {
+		return "", fmt.Errorf("cannot derive a web URL from REST prefix %q; set web_base_url in your config", restPrefix)
+	}
+	return fmt.Sprintf("%s://%s/%s/tickets/%s", prefix.Scheme, prefix.Host, url.PathEscape(orgID), url.PathEscape(ticketID)), nil
+}
+
+// openTicketURL prints the URL, then opens it in the browser unless printOnly
+func openTicketURL(browser Browser, ticketURL string, output io.Writer, printOnly bool) error {
+	fmt.Fprintln(output, ticketURL)
+	if printOnly {
+		return nil
+	}
+	if err := browser.Open(ticketURL); err != nil {
+		return fmt.Errorf("could not open browser: %w (use --print-url to only print the URL)", err)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// fakeBrowser records the opened URL, or fails with err when set
+type fakeBrowser struct {
+	opened string
+	err    error
+}
+
+func (f *fakeBrowser) Open(url string) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.opened = url
+	return nil
+}
+
+// TestTicketWebURL tests building the web URL that fb open launches
+//
+// Acceptance Criteria:
+// - A configured web_base_url is used as is, with or without a trailing slash
+// - Otherwise the URL uses the REST prefix's scheme and host with the org ID
+// - Ticket and org IDs are path-escaped
+// - A REST prefix without a host is an error suggesting web_base_url
+func TestTicketWebURL(t *testing.T) {
+	tests := []struct {
+		name       string
+		webBaseURL string
+		restPrefix string
+		expected   string
+	}{
+		{"Given web_base_url When building the URL Then it is used", "https://fb.example.com/acme/", "https://api.example.com/rest/2", "https://fb.example.com/acme/tickets/TICKET-1"},
+		{"Given only a REST prefix When building the URL Then its host and the org are used", "", "https://api.example.com/rest/2/acme", "https://api.example.com/acme/tickets/TICKET-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ticketWebURL(tt.webBaseURL, tt.restPrefix, "acme", "TICKET-1")
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+
+	t.Run("Given an ID with a slash When building the URL Then it is escaped", func(t *testing.T) {
+		got, _ := ticketWebURL("https://fb.example.com", "", "acme", "A/B")
+		if got != "https://fb.example.com/tickets/A%2FB" {
+			t.Errorf("Expected escaped ID, got %q", got)
+		}
+	})
+
+	t.Run("Given an unusable REST prefix When building the URL Then the error suggests web_base_url", func(t *testing.T) {
+		if _, err := ticketWebURL("", "", "acme", "TICKET-1"); err == nil || !strings.Contains(err.Error(), "web_base_url") {
+			t.Errorf("Expected web_base_url hint, got %v", err)
+		}
+	})
+}
+
+// TestOpenTicketURL tests printing and launching the ticket URL
+//
+// Acceptance Criteria:
+// - The URL is always printed
+// - The browser is launched unless --print-url is given
+// - A browser failure is returned as an error mentioning --print-url
+func TestOpenTicketURL(t *testing.T) {
+	const ticketURL = "https://fb.example.com/tickets/TICKET-1"
+
+	t.Run("Given a URL When opening Then it is printed and launched", func(t *testing.T) {
+		browser := &fakeBrowser{}
+		var output bytes.Buffer
+
+		if err := openTicketURL(browser, ticketURL, &output, false); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if output.String() != ticketURL+"\n" || browser.opened != ticketURL {
+			t.Errorf("Expected URL printed and opened, got output %q opened %q", output.String(), browser.opened)
+		}
+	})
+
+	t.Run("Given --print-url When opening Then the browser is not launched", func(t *testing.T) {
+		browser := &fakeBrowser{}
+		var output bytes.Buffer
+
+		if err := openTicketURL(browser, ticketURL, &output, true); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if output.String() != ticketURL+"\n" || browser.opened != "" {
+			t.Errorf("Expected URL printed only, got output %q opened %q", output.String(), browser.opened)
+		}
+	})
+
+	t.Run("Given a failing opener When opening Then the error suggests --print-url", func(t *testing.T) {
+		browser := &fakeBrowser{err: errors.New("xdg-open not found")}
+		var output bytes.Buffer
+
+		err := openTicketURL(browser, ticketURL, &output, false)
+		if err == nil || !strings.Contains(err.Error(), "--print-url") {
+			t.Errorf("Expected error suggesting --print-url, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Germanicus1/fb/internal/state"
+)
+
+// PromptOptions controls what `fb prompt` prints
+type PromptOptions struct {
+	Count bool // Include the cached assigned-ticket count
+	Stale bool // Show how old the cached count is
+}
+
+// ExecutePrompt prints a compact, single-line segment for shell prompts and status bars.
+// It only reads local state, never the network, so it is safe to run on every prompt redraw.
+func ExecutePrompt(opts PromptOptions) error {
+	return writePrompt(os.Stdout, opts, time.Now())
+}
+
+// writePrompt writes the checked-out ticket ID and, with Count, the cached ticket count.
+// Nothing is written when there is nothing to show.
+func writePrompt(output io.Writer, opts PromptOptions, now time.Time) error {
+	var parts []string
+
+	if checkout, err := state.LoadCheckout(); err == nil {
+		parts = append(parts, checkout.TicketID)
+	}
+
+	if opts.Count {
+		parts = append(parts, formatCachedCount(opts, now))
+	}
+
+	if len(parts) > 0 {
+		fmt.Fprintln(output, strings.Join(parts, " "))
+	}
+	return nil
+}
+
+// formatCachedCount formats the cached ticket count, e.g. "5", or "5 (2 hours ago)" with
+// Stale. It shows "?" when no count has been cached yet.
+func formatCachedCount(opts PromptOptions, now time.Time) string {
+	cached, err := state.LoadTicketCount()
+	if err != nil {
+		return "?"
+	}
+
+	count := fmt.Sprintf("%d", cached.Count)
+	if !opts.Stale {
+		return count
+	}
+
+	fetchedAt, err := time.Parse(time.RFC3339, cached.FetchedAt)
+	if err != nil {
+		return count
+	}
+	return fmt.Sprintf("%s (%s ago)", count, formatDuration(now.Sub(fetchedAt)))
+}
+
+// cacheTicketCount records the assigned-ticket count for `fb prompt --count`.
+// Caching is best effort, so a failure never fails the command that fetched the tickets.
+func cacheTicketCount(count int) {
+	state.SaveTicketCount(count, time.Now())
+}
@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Germanicus1/fb/internal/state"
+)
+
+// TestPromptCachedCount tests `fb prompt --count` reading the cached ticket count
+//
+// Acceptance Criteria:
+// - The prompt shows the checked-out ticket ID and the cached count without any HTTP call
+// - With --stale, the age of the cached count is shown
+// - A missing cache shows "?" rather than failing
+func TestPromptCachedCount(t *testing.T) {
+	setHome := func(t *testing.T) {
+		tempDir := t.TempDir()
+		originalHome := os.Getenv("HOME")
+		os.Setenv("HOME", tempDir)
+		t.Cleanup(func() { os.Setenv("HOME", originalHome) })
+	}
+
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Given a cached count and a checkout When rendering the prompt Then both are shown", func(t *testing.T) {
+		setHome(t)
+		if err := state.SaveTicketCount(5, now.Add(-2*time.Hour)); err != nil {
+			t.Fatalf("Failed to save ticket count: %v", err)
+		}
+		if err := state.SaveCheckout(&state.CheckoutState{TicketID: "TICKET-001", TicketName: "Fix login bug"}); err != nil {
+			t.Fatalf("Failed to save checkout: %v", err)
+		}
+
+		var output bytes.Buffer
+		if err := writePrompt(&output, PromptOptions{Count: true}, now); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if output.String() != "TICKET-001 5\n" {
+			t.Errorf("Expected %q, got %q", "TICKET-001 5\n", output.String())
+		}
+	})
+
+	t.Run("Given a cached count When rendering with staleness Then the age is shown", func(t *testing.T) {
+		setHome(t)
+		if err := state.SaveTicketCount(3, now.Add(-2*time.Hour)); err != nil {
+			t.Fatalf("Failed to save ticket count: %v", err)
+		}
+
+		var output bytes.Buffer
+		writePrompt(&output, PromptOptions{Count: true, Stale: true}, now)
+
+		if output.String() != "3 (2 hours ago)\n" {
+			t.Errorf("Expected %q, got %q", "3 (2 hours ago)\n", output.String())
+		}
+	})
+
+	t.Run("Given no cached count When rendering the count Then a placeholder is shown", func(t *testing.T) {
+		setHome(t)
+
+		var output bytes.Buffer
+		writePrompt(&output, PromptOptions{Count: true}, now)
+
+		if output.String() != "?\n" {
+			t.Errorf("Expected %q, got %q", "?\n", output.String())
+		}
+	})
+
+	t.Run("Given nothing checked out and no count requested When rendering Then nothing is printed", func(t *testing.T) {
+		setHome(t)
+
+		var output bytes.Buffer
+		writePrompt(&output, PromptOptions{}, now)
+
+		if output.Len() != 0 {
+			t.Errorf("Expected no output, got %q", output.String())
+		}
+	})
+}
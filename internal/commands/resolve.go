@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Germanicus1/fb/api"
+	"github.com/Germanicus1/fb/config"
+	"github.com/Germanicus1/fb/internal/service"
+)
+
+// ExecuteResolveBin prints the ID of the single bin with the given name, or errors if
+// the name is unknown or shared by several bins
+func ExecuteResolveBin(ctx context.Context, svcOpts service.Options, cfg *config.Config, name string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("bin name is required")
+	}
+
+	ticketService, err := service.NewTicketService(ctx, cfg, svcOpts)
+	if err != nil {
+		return err
+	}
+
+	bins, err := ticketService.GetBins()
+	if err != nil {
+		return err
+	}
+
+	bin, err := api.FindBin(bins, name)
+	if err != nil {
+		return err
+	}
+	fmt.Println(bin.ID)
+	return nil
+}
+
+// ExecuteResolveBoard prints the ID of the single board with the given name, or errors if
+// the name is unknown or shared by several boards
+func ExecuteResolveBoard(ctx context.Context, svcOpts service.Options, cfg *config.Config, name string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("board name is required")
+	}
+
+	ticketService, err := service.NewTicketService(ctx, cfg, svcOpts)
+	if err != nil {
+		return err
+	}
+
+	boards, err := ticketService.GetBoards()
+	if err != nil {
+		return err
+	}
+
+	board, err := api.FindBoard(boards, name)
+	if err != nil {
+		return err
+	}
+	fmt.Println(board.ID)
+	return nil
+}
@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/Germanicus1/fb/config"
+	"github.com/Germanicus1/fb/formatter"
+	"github.com/Germanicus1/fb/internal/service"
+	"github.com/Germanicus1/fb/models"
+)
+
+// ExecuteShow prints the full detail of one assigned ticket, found by its ID or by a prefix
+// of the ID that only one assigned ticket has
+func ExecuteShow(ctx context.Context, svcOpts service.Options, cfg *config.Config, ticketID string) error {
+	if err := validateTicketID(ticketID); err != nil {
+		return err
+	}
+
+	tickets, err := service.FetchAssignedTickets(ctx, cfg, svcOpts)
+	if err != nil {
+		return err
+	}
+
+	return showTicket(tickets, ticketID, os.Stdout)
+}
+
+// showTicket writes the ticket matching query with FormatTicket. When none or several match,
+// it returns an error saying so, listing the matches, and writes nothing.
+func showTicket(tickets []models.Ticket, query string, output io.Writer) error {
+	matches := findTicketsByIDPrefix(tickets, query)
+
+	switch len(matches) {
+	case 0:
+		return fmt.Errorf("ticket %s not found among your assigned tickets", query)
+	case 1:
+		fmt.Fprint(output, formatter.FormatTicket(matches[0]))
+		return nil
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "ticket ID prefix '%s' matches %d tickets; use a longer prefix:", query, len(matches))
+	for _, ticket := range matches {
+		fmt.Fprintf(&b, "\n  [%s] %s", ticket.ID, ticket.Name)
+	}
+	return fmt.Errorf("%s", b.String())
+}
+
+// findTicketsByIDPrefix returns the ticket whose ID is exactly query or, failing that, every
+// ticket whose ID starts with query
+func findTicketsByIDPrefix(tickets []models.Ticket, query string) []models.Ticket {
+	for _, ticket := range tickets {
+		if ticket.ID == query {
+			return []models.Ticket{ticket}
+		}
+	}
+
+	var matches []models.Ticket
+	for _, ticket := range tickets {
+		if strings.HasPrefix(ticket.ID, query) {
+			matches = append(matches, ticket)
+		}
+	}
+	return matches
+}
@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestShowTicket tests finding and rendering one ticket for fb show
+//
+// Acceptance Criteria:
+// - An exact ID renders the ticket with FormatTicket
+// - A prefix of exactly one ticket's ID renders that ticket
+// - An exact ID wins over longer IDs sharing it as a prefix
+// - An unknown ID is an error naming it, and nothing is written
+// - A prefix shared by several tickets is an error listing them, and nothing is written
+func TestShowTicket(t *testing.T) {
+	tickets := []models.Ticket{
+		{ID: "abc123", Name: "First ticket", Description: "Details"},
+		{ID: "abc456", Name: "Second ticket"},
+		{ID: "xyz789", Name: "Third ticket"},
+		{ID: "xyz7890", Name: "Fourth ticket"},
+	}
+
+	t.Run("Given an exact ID When showing Then the ticket is rendered in full", func(t *testing.T) {
+		var output bytes.Buffer
+
+		if err := showTicket(tickets, "abc123", &output); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if output.String() != "Ticket ID: abc123\nTicket Name: First ticket\nStatus: Unknown\nDescription: Details\n" {
+			t.Errorf("Unexpected output:\n%s", output.String())
+		}
+	})
+
+	t.Run("Given a unique ID prefix When showing Then the matching ticket is rendered", func(t *testing.T) {
+		var output bytes.Buffer
+
+		if err := showTicket(tickets, "abc4", &output); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !strings.Contains(output.String(), "Ticket ID: abc456") {
+			t.Errorf("Expected ticket abc456, got:\n%s", output.String())
+		}
+	})
+
+	t.Run("Given an exact ID that prefixes another When showing Then the exact match wins", func(t *testing.T) {
+		var output bytes.Buffer
+
+		if err := showTicket(tickets, "xyz789", &output); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !strings.Contains(output.String(), "Ticket Name: Third ticket") {
+			t.Errorf("Expected the exact match, got:\n%s", output.String())
+		}
+	})
+
+	t.Run("Given an unknown ID When showing Then a not found message is printed and an error returned", func(t *testing.T) {
+		var output bytes.Buffer
+
+		err := showTicket(tickets, "nope", &output)
+
+		if err == nil || err.Error() != "ticket nope not found among your assigned tickets" {
+			t.Fatalf("Expected a not found error, got %v", err)
+		}
+		if output.Len() != 0 {
+			t.Errorf("Expected no output, got %q", output.String())
+		}
+	})
+
+	t.Run("Given an ambiguous prefix When showing Then the matches are listed and an error returned", func(t *testing.T) {
+		var output bytes.Buffer
+
+		err := showTicket(tickets, "abc", &output)
+
+		if err == nil {
+			t.Fatal("Expected an error for an ambiguous prefix")
+		}
+		if !strings.Contains(err.Error(), "[abc123] First ticket") || !strings.Contains(err.Error(), "[abc456] Second ticket") {
+			t.Errorf("Expected both matches listed, got:\n%v", err)
+		}
+		if output.Len() != 0 {
+			t.Errorf("Expected no output, got %q", output.String())
+		}
+	})
+}
@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/Germanicus1/fb/internal/state"
+	"github.com/Germanicus1/fb/models"
+)
+
+// TestStaleCheckoutNote tests warning about a checkout that left the assigned list
+//
+// Acceptance Criteria:
+// - A checked-out ticket missing from the assigned tickets produces a note naming it
+// - A checked-out ticket still in the list produces no note
+// - With nothing checked out there is no note
+func TestStaleCheckoutNote(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	tickets := []models.Ticket{
+		{ID: "TICKET-002", Name: "Add tests"},
+		{ID: "TICKET-003", Name: "Update docs"},
+	}
+
+	t.Run("Given no checkout When listing Then there is no note", func(t *testing.T) {
+		if note := staleCheckoutNote(tickets); note != "" {
+			t.Errorf("Expected no note, got %q", note)
+		}
+	})
+
+	t.Run("Given a checked-out ticket in the list When listing Then there is no note", func(t *testing.T) {
+		if err := state.SaveCheckout(&state.CheckoutState{TicketID: "TICKET-002", TicketName: "Add tests"}); err != nil {
+			t.Fatalf("Failed to save checkout: %v", err)
+		}
+
+		if note := staleCheckoutNote(tickets); note != "" {
+			t.Errorf("Expected no note, got %q", note)
+		}
+	})
+
+	t.Run("Given a checked-out ticket missing from the list When listing Then the note names it", func(t *testing.T) {
+		if err := state.SaveCheckout(&state.CheckoutState{TicketID: "TICKET-001", TicketName: "Fix login bug"}); err != nil {
+			t.Fatalf("Failed to save checkout: %v", err)
+		}
+
+		expected := "Note: your checked-out ticket TICKET-001 is no longer in your assigned tickets.\n"
+		if note := staleCheckoutNote(tickets); note != expected {
+			t.Errorf("Expected %q, got %q", expected, note)
+		}
+	})
+}
@@ -1,37 +1,130 @@
 package commands
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/Germanicus1/fb/config"
+	"github.com/Germanicus1/fb/formatter"
+	"github.com/Germanicus1/fb/internal/service"
 	"github.com/Germanicus1/fb/internal/state"
 )
 
-// ExecuteStatus displays the currently checked-out ticket
+// ExecuteStatus displays the currently checked-out ticket.
+// It only reads local state, so it stays fast and works offline.
 func ExecuteStatus() error {
+	return writeCheckoutStatus(os.Stdout)
+}
+
+// ExecuteStatusJSON writes the checked-out ticket as a single JSON object for scripts.
+// A missing checkout is reported as {"checked_out": false} rather than an error.
+func ExecuteStatusJSON() error {
+	return writeCheckoutStatusJSON(os.Stdout, time.Now())
+}
+
+// ExecuteStatusWithCounts displays the checked-out ticket followed by a one-line
+// summary of assigned ticket counts by bin, which requires an API round-trip
+func ExecuteStatusWithCounts(ctx context.Context, svcOpts service.Options, cfg *config.Config) error {
+	if err := writeCheckoutStatus(os.Stdout); err != nil {
+		return err
+	}
+
+	tickets, err := service.FetchAssignedTickets(ctx, cfg, svcOpts)
+	if err != nil {
+		return err
+	}
+	cacheTicketCount(len(tickets))
+
+	fmt.Printf("\n%s\n", formatter.FormatBinCountsLine(tickets))
+	return nil
+}
+
+// writeCheckoutStatus writes the checked-out ticket, or a hint when nothing is checked out
+func writeCheckoutStatus(output io.Writer) error {
 	checkout, err := state.LoadCheckout()
 	if err != nil {
-		fmt.Println("No ticket currently checked out")
-		fmt.Println("Use 'fb checkout --bin \"Bin Name\"' to check out a ticket")
+		fmt.Fprintln(output, "No ticket currently checked out")
+		fmt.Fprintln(output, "Use 'fb checkout --bin \"Bin Name\"' to check out a ticket")
 		return nil
 	}
 
-	fmt.Println("Currently checked out:")
-	fmt.Printf("  Ticket: [%s] %s\n", checkout.TicketID, checkout.TicketName)
+	fmt.Fprintln(output, "Currently checked out:")
+	fmt.Fprintf(output, "  Ticket: [%s] %s\n", checkout.TicketID, checkout.TicketName)
 	if checkout.BinName != "" {
-		fmt.Printf("  Bin: %s\n", checkout.BinName)
+		fmt.Fprintf(output, "  Bin: %s\n", checkout.BinName)
 	}
 
 	// Show time since checkout
-	checkedOutTime, err := time.Parse(time.RFC3339, checkout.CheckedOutAt)
+	checkedOutTime, err := parseCheckedOutAt(checkout.CheckedOutAt)
 	if err == nil {
 		duration := time.Since(checkedOutTime)
-		fmt.Printf("  Checked out: %s ago\n", formatDuration(duration))
+		fmt.Fprintf(output, "  Checked out: %s ago\n", formatDuration(duration))
 	}
 
 	return nil
 }
 
+// checkoutStatusJSON is the --json shape of fb status. Ticket fields are omitted
+// when nothing is checked out; the duration is a pointer so zero seconds still appears.
+type checkoutStatusJSON struct {
+	CheckedOut        bool   `json:"checked_out"`
+	TicketID          string `json:"ticket_id,omitempty"`
+	TicketName        string `json:"ticket_name,omitempty"`
+	BinID             string `json:"bin_id,omitempty"`
+	BinName           string `json:"bin_name,omitempty"`
+	CheckedOutAt      string `json:"checked_out_at,omitempty"`
+	CheckedOutSeconds *int64 `json:"checked_out_seconds,omitempty"`
+}
+
+// writeCheckoutStatusJSON writes the checkout state as JSON, measuring the duration against now
+func writeCheckoutStatusJSON(output io.Writer, now time.Time) error {
+	status := checkoutStatusJSON{}
+
+	checkout, err := state.LoadCheckout()
+	if err == nil {
+		status.CheckedOut = true
+		status.TicketID = checkout.TicketID
+		status.TicketName = checkout.TicketName
+		status.BinID = checkout.BinID
+		status.BinName = checkout.BinName
+
+		if checkedOutTime, err := parseCheckedOutAt(checkout.CheckedOutAt); err == nil {
+			status.CheckedOutAt = checkedOutTime.Format(time.RFC3339)
+			seconds := int64(now.Sub(checkedOutTime).Seconds())
+			if seconds < 0 {
+				seconds = 0
+			}
+			status.CheckedOutSeconds = &seconds
+		}
+	}
+
+	data, err := json.Marshal(status)
+	if err != nil {
+		return fmt.Errorf("failed to encode checkout status: %w", err)
+	}
+	_, err = fmt.Fprintf(output, "%s\n", data)
+	return err
+}
+
+// parseCheckedOutAt parses a stored checkout timestamp. New checkouts are written as
+// RFC3339, but older checkout files may hold Unix seconds, so both are accepted.
+func parseCheckedOutAt(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if checkedOutTime, err := time.Parse(time.RFC3339, value); err == nil {
+		return checkedOutTime, nil
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid checkout timestamp %q", value)
+}
+
 // formatDuration formats a duration into a human-readable string
 func formatDuration(d time.Duration) string {
 	if d < time.Minute {
@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Germanicus1/fb/internal/state"
+)
+
+// TestStatusCommand tests the `fb status` command output
+//
+// Acceptance Criteria:
+// - With a checkout, status shows the ticket ID, name, bin, and time since checkout
+// - Without a checkout, status prints a friendly hint and succeeds
+func TestStatusCommand(t *testing.T) {
+	t.Run("Given a checked-out ticket When running status Then the ticket is reported", func(t *testing.T) {
+		tempDir := t.TempDir()
+		originalHome := os.Getenv("HOME")
+		os.Setenv("HOME", tempDir)
+		defer os.Setenv("HOME", originalHome)
+
+		checkout := &state.CheckoutState{
+			TicketID:     "TICKET-001",
+			TicketName:   "Fix login bug",
+			BinID:        "bin-doing",
+			BinName:      "Doing",
+			CheckedOutAt: time.Now().Add(-3 * time.Hour).Format(time.RFC3339),
+		}
+		if err := state.SaveCheckout(checkout); err != nil {
+			t.Fatalf("Failed to save checkout: %v", err)
+		}
+
+		var output bytes.Buffer
+		if err := writeCheckoutStatus(&output); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		outputStr := output.String()
+		for _, expected := range []string{"[TICKET-001] Fix login bug", "Bin: Doing", "Checked out: 3 hours ago"} {
+			if !strings.Contains(outputStr, expected) {
+				t.Errorf("Expected output to contain %q, got:\n%s", expected, outputStr)
+			}
+		}
+	})
+
+	t.Run("Given no checkout When running status Then a hint is shown without error", func(t *testing.T) {
+		tempDir := t.TempDir()
+		originalHome := os.Getenv("HOME")
+		os.Setenv("HOME", tempDir)
+		defer os.Setenv("HOME", originalHome)
+
+		var output bytes.Buffer
+		if err := writeCheckoutStatus(&output); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if !strings.Contains(output.String(), "No ticket currently checked out") {
+			t.Errorf("Expected no-checkout message, got:\n%s", output.String())
+		}
+	})
+}
@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Germanicus1/fb/internal/state"
+)
+
+// TestStatusJSON tests the `fb status --json` output
+//
+// Acceptance Criteria:
+// - With a checkout, the JSON carries the ticket and bin fields, an RFC3339 timestamp, and checked_out_seconds
+// - Without a checkout, the output is exactly {"checked_out":false} and no error is returned
+func TestStatusJSON(t *testing.T) {
+	t.Run("Given a checked-out ticket When writing JSON status Then all fields are present", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		checkedOutAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+		checkout := &state.CheckoutState{
+			TicketID:     "TICKET-001",
+			TicketName:   "Fix login bug",
+			BinID:        "bin-doing",
+			BinName:      "Doing",
+			CheckedOutAt: checkedOutAt.Format(time.RFC3339),
+		}
+		if err := state.SaveCheckout(checkout); err != nil {
+			t.Fatalf("Failed to save checkout: %v", err)
+		}
+
+		var output bytes.Buffer
+		if err := writeCheckoutStatusJSON(&output, checkedOutAt.Add(90*time.Minute)); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		var got map[string]interface{}
+		if err := json.Unmarshal(output.Bytes(), &got); err != nil {
+			t.Fatalf("Expected valid JSON, got %v:\n%s", err, output.String())
+		}
+
+		expected := map[string]interface{}{
+			"checked_out":         true,
+			"ticket_id":           "TICKET-001",
+			"ticket_name":         "Fix login bug",
+			"bin_id":              "bin-doing",
+			"bin_name":            "Doing",
+			"checked_out_at":      "2025-03-01T09:00:00Z",
+			"checked_out_seconds": float64(5400),
+		}
+		for key, want := range expected {
+			if got[key] != want {
+				t.Errorf("Expected %s = %v, got %v", key, want, got[key])
+			}
+		}
+	})
+
+	t.Run("Given no checkout When writing JSON status Then checked_out is false", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		var output bytes.Buffer
+		if err := writeCheckoutStatusJSON(&output, time.Now()); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if output.String() != "{\"checked_out\":false}\n" {
+			t.Errorf("Expected {\"checked_out\":false}, got %q", output.String())
+		}
+	})
+}
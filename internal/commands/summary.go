@@ -0,0 +1,35 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Germanicus1/fb/config"
+	"github.com/Germanicus1/fb/formatter"
+	"github.com/Germanicus1/fb/internal/service"
+)
+
+// ExecuteSummary displays per-bin ticket counts with the number of tickets due soon.
+// A zero dueSoonWindow falls back to the configured window, then to the formatter default.
+func ExecuteSummary(ctx context.Context, svcOpts service.Options, cfg *config.Config, dueSoonWindow time.Duration) error {
+	tickets, err := service.FetchAssignedTickets(ctx, cfg, svcOpts)
+	if err != nil {
+		return err
+	}
+	cacheTicketCount(len(tickets))
+
+	fmt.Print(formatter.FormatBinSummary(tickets, time.Now(), resolveDueSoonWindow(cfg, dueSoonWindow)))
+	return nil
+}
+
+// resolveDueSoonWindow picks the due-soon window from the flag, the config, or the default
+func resolveDueSoonWindow(cfg *config.Config, flagWindow time.Duration) time.Duration {
+	if flagWindow > 0 {
+		return flagWindow
+	}
+	if configured := cfg.DueSoonWindow(); configured > 0 {
+		return configured
+	}
+	return formatter.DefaultDueSoonWindow
+}
@@ -1,10 +1,12 @@
 package commands
 
 import (
+	"context"
 	"io"
 	"os"
 	"path/filepath"
 
+	"github.com/Germanicus1/fb/internal/service"
 	"github.com/Germanicus1/fb/internal/state"
 )
 
@@ -12,7 +14,7 @@ import (
 
 // runQuickComment is a test helper for quick comment functionality
 func runQuickComment(output io.Writer, comment string) error {
-	return ExecuteQuick(comment)
+	return ExecuteQuick(context.Background(), service.Options{}, comment)
 }
 
 // getCheckoutFilePathForRead returns the path to the checkout state file for testing
@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// validateTicketID catches obvious ticket ID mistakes, such as shell-quoting errors,
+// before any API call is made. IDs must be non-empty and free of whitespace and control characters.
+func validateTicketID(id string) error {
+	if id == "" {
+		return fmt.Errorf("ticket ID cannot be empty")
+	}
+
+	for _, r := range id {
+		if unicode.IsSpace(r) || unicode.IsControl(r) {
+			return fmt.Errorf("invalid ticket ID %q: must not contain whitespace or control characters (check your shell quoting)", id)
+		}
+	}
+
+	return nil
+}
+
+// validateCheckoutQuery checks the argument of fb checkout before any API call. It is held to
+// validateTicketID's rules, except that a value containing spaces is accepted as a name
+// substring; control characters are rejected either way.
+func validateCheckoutQuery(query string) error {
+	if strings.TrimSpace(query) == "" {
+		return fmt.Errorf("ticket ID or name cannot be empty")
+	}
+
+	for _, r := range query {
+		if unicode.IsControl(r) {
+			return fmt.Errorf("invalid ticket ID or name %q: must not contain control characters (check your shell quoting)", query)
+		}
+	}
+
+	if strings.ContainsFunc(query, unicode.IsSpace) {
+		return nil
+	}
+	return validateTicketID(query)
+}
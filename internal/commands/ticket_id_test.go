@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"testing"
+)
+
+// TestValidateTicketID tests early validation of ticket IDs passed on the command line
+func TestValidateTicketID(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		wantErr bool
+	}{
+		{"empty ID", "", true},
+		{"ID with inner space", "yL4rj YNU5", true},
+		{"ID with trailing space", "yL4rjYNU5 ", true},
+		{"ID with newline", "yL4rjYNU5\n", true},
+		{"ID with tab", "\tyL4rjYNU5", true},
+		{"ID with control character", "yL4rj\x00YNU5", true},
+		{"alphanumeric ID", "yL4rjYNU5PMlu7K8B", false},
+		{"ID with dashes", "TICKET-001", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTicketID(tt.id)
+			if tt.wantErr && err == nil {
+				t.Errorf("Expected error for %q, got nil", tt.id)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Expected no error for %q, got %v", tt.id, err)
+			}
+		})
+	}
+}
+
+// TestValidateCheckoutQuery tests early validation of the fb checkout argument
+//
+// Acceptance Criteria:
+// - Empty and control-character values are rejected before any API call
+// - Values with spaces are accepted as name substrings
+// - Other values are held to the ticket ID rules
+func TestValidateCheckoutQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		wantErr bool
+	}{
+		{"empty query", "", true},
+		{"blank query", "   ", true},
+		{"name with control character", "login\x00page", true},
+		{"name with newline", "login page\n", true},
+		{"name substring with spaces", "login page", false},
+		{"alphanumeric ID", "yL4rjYNU5PMlu7K8B", false},
+		{"one-word name substring", "login", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCheckoutQuery(tt.query)
+			if tt.wantErr && err == nil {
+				t.Errorf("Expected error for %q, got nil", tt.query)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Expected no error for %q, got %v", tt.query, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/config"
+)
+
+// TestFetchAssignedTicketsMethod tests fetching the configured user's tickets on an existing service
+//
+// Acceptance Criteria:
+// - The search is made for the configured user's ID, with the bin filter when one is given
+// - A user already looked up on the service is not looked up again, even with RefreshUser
+func TestFetchAssignedTicketsMethod(t *testing.T) {
+	setup := func(t *testing.T) (*TicketService, *int, *string) {
+		t.Setenv("HOME", t.TempDir())
+
+		lookups := 0
+		var searchQuery string
+		var server *httptest.Server
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case strings.HasPrefix(r.URL.Path, "/users/"):
+				lookups++
+				fmt.Fprint(w, `{"_id": "user-1", "email": "me@example.com"}`)
+			case r.URL.Path == "/ticket-search":
+				searchQuery = r.URL.RawQuery
+				fmt.Fprint(w, `[{"_id": "T1", "name": "One"}]`)
+			default:
+				fmt.Fprintf(w, `{"restUrlPrefix": "%s"}`, server.URL)
+			}
+		}))
+		t.Cleanup(server.Close)
+
+		cfg := &config.Config{AuthKey: "test-key", OrgID: "org-123", UserEmail: "me@example.com", RestDirectoryURL: server.URL, RefreshUser: true}
+		svc, err := NewTicketService(context.Background(), cfg, Options{})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		return svc, &lookups, &searchQuery
+	}
+
+	t.Run("Given a bin ID When fetching Then the user's tickets in that bin are searched", func(t *testing.T) {
+		svc, _, searchQuery := setup(t)
+
+		tickets, err := svc.FetchAssignedTickets("bin-1")
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(tickets) != 1 || tickets[0].ID != "T1" {
+			t.Errorf("Expected T1, got %+v", tickets)
+		}
+		if *searchQuery != "users=user-1&bins=bin-1" {
+			t.Errorf("Expected a search for user-1 in bin-1, got %q", *searchQuery)
+		}
+	})
+
+	t.Run("Given the user was already looked up When fetching Then no second lookup is made", func(t *testing.T) {
+		svc, lookups, _ := setup(t)
+
+		if _, err := svc.GetCurrentUser("me@example.com"); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if _, err := svc.FetchAssignedTickets(""); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if *lookups != 1 {
+			t.Errorf("Expected 1 lookup, got %d", *lookups)
+		}
+	})
+}
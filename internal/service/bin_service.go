@@ -1,25 +1,37 @@
 package service
 
 import (
+	"errors"
 	"fmt"
 	"unicode"
 
 	"github.com/Germanicus1/fb/api"
+	"github.com/Germanicus1/fb/models"
 )
 
-// ResolveBinFilter converts a bin name to a bin ID.
-// If the input is already a bin ID (alphanumeric only), it returns it unchanged.
-// Otherwise, it performs a case-insensitive lookup to find the matching bin ID.
-func ResolveBinFilter(client *api.Client, binFilter string) (string, error) {
-	if IsBinID(binFilter) {
-		return binFilter, nil
+// ResolveBinFilter finds the bin a --bin value names, by exact ID or case-insensitive name.
+// Names are checked against the bin list even when they look like IDs, so a one-word name
+// shared by several bins is reported as ambiguous. A value shaped like an ID that is not in
+// the list, or when the list cannot be fetched, is passed through as an ID with no Name.
+func ResolveBinFilter(client *api.Client, binFilter string) (models.Bin, error) {
+	bin, err := client.LookupBin(binFilter)
+	if err == nil {
+		return bin, nil
 	}
+	if IsBinID(binFilter) && !errors.Is(err, api.ErrAmbiguousBinName) {
+		return models.Bin{ID: binFilter}, nil
+	}
+	return models.Bin{}, BinLookupError(binFilter, err)
+}
 
-	binID, err := client.LookupBinIDByName(binFilter)
-	if err != nil {
-		return "", fmt.Errorf("failed to find bin '%s': %w", binFilter, err)
+// BinLookupError wraps a failed lookup of the --bin value binFilter as "failed to find bin
+// 'X': ...". A not-found error is reduced to ErrBinNotFound, since the lookup already appended
+// the name; errors.Is still matches either sentinel.
+func BinLookupError(binFilter string, err error) error {
+	if errors.Is(err, api.ErrBinNotFound) {
+		err = api.ErrBinNotFound
 	}
-	return binID, nil
+	return fmt.Errorf("failed to find bin '%s': %w", binFilter, err)
 }
 
 // IsBinID determines if a string is a bin ID based on its format.
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/api"
+	"github.com/Germanicus1/fb/config"
+)
+
+// TestResolveBinFilter tests resolving a --bin value against the bin list
+//
+// Acceptance Criteria:
+// - A one-word name shared by several bins is ambiguous, not passed through as an ID
+// - A unique name resolves to its bin, including the name
+// - A server bin holding none of the user's tickets is still a valid bin, not an unknown one
+// - An ID-shaped value missing from the list is passed through as an ID with no name
+// - An unknown multi-word name is ErrBinNotFound, named once in the error
+func TestResolveBinFilter(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/bins") {
+			fmt.Fprint(w, `[
+				{"_id": "binA", "name": "Doing"},
+				{"_id": "binP", "name": "In Progress"},
+				{"_id": "binB", "name": "doing"},
+				{"_id": "binX", "name": "Blocked"}
+			]`)
+			return
+		}
+		fmt.Fprintf(w, `{"restUrlPrefix": "%s"}`, server.URL)
+	}))
+	t.Cleanup(server.Close)
+
+	svc, err := NewTicketService(context.Background(), &config.Config{AuthKey: "test-key", OrgID: "org-123", UserEmail: "me@example.com", RestDirectoryURL: server.URL}, Options{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	client := svc.GetClient()
+
+	t.Run("Given two bins named Doing When resolving Doing Then the name is ambiguous", func(t *testing.T) {
+		_, err := ResolveBinFilter(client, "Doing")
+		if !errors.Is(err, api.ErrAmbiguousBinName) {
+			t.Fatalf("Expected ErrAmbiguousBinName, got %v", err)
+		}
+	})
+
+	t.Run("Given a unique name When resolving Then its bin is returned", func(t *testing.T) {
+		bin, err := ResolveBinFilter(client, "in progress")
+		if err != nil || bin.ID != "binP" || bin.Name != "In Progress" {
+			t.Errorf("Expected binP In Progress, got %+v, %v", bin, err)
+		}
+	})
+
+	t.Run("Given a server bin with no tickets When resolving Then it resolves without error", func(t *testing.T) {
+		bin, err := ResolveBinFilter(client, "blocked")
+		if err != nil || bin.ID != "binX" {
+			t.Errorf("Expected binX, got %+v, %v", bin, err)
+		}
+	})
+
+	t.Run("Given an unlisted ID When resolving Then it is passed through without a name", func(t *testing.T) {
+		bin, err := ResolveBinFilter(client, "binZ")
+		if err != nil || bin.ID != "binZ" || bin.Name != "" {
+			t.Errorf("Expected pass-through binZ, got %+v, %v", bin, err)
+		}
+	})
+
+	t.Run("Given an unknown name When resolving Then ErrBinNotFound is returned", func(t *testing.T) {
+		_, err := ResolveBinFilter(client, "Not There")
+		if !errors.Is(err, api.ErrBinNotFound) {
+			t.Fatalf("Expected ErrBinNotFound, got %v", err)
+		}
+		if err.Error() != "failed to find bin 'Not There': bin not found" {
+			t.Errorf("Expected the bin named once, got %q", err.Error())
+		}
+	})
+}
@@ -36,10 +36,12 @@ func BuildCommentPayload(commentID, ticketID, comment string) models.CommentPayl
 	}
 }
 
-// PostComment posts a comment to a ticket
-func PostComment(client *api.Client, payload models.CommentPayload) error {
-	if err := client.PostComment(payload); err != nil {
-		return fmt.Errorf("failed to post comment: %w", err)
+// PostComment posts a comment to a ticket and returns the API's response, which tells
+// whether the comment was confirmed as saved and its ID
+func (s *TicketService) PostComment(payload models.CommentPayload) (*api.CommentResult, error) {
+	result, err := s.client.PostCommentWithResultContext(s.ctx, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to post comment: %w", err)
 	}
-	return nil
+	return result, nil
 }
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Germanicus1/fb/config"
+)
+
+// TestServiceContextCancellation tests that a ticket service sends its writes with its context
+//
+// Acceptance Criteria:
+// - Once the service's context is cancelled, ticket updates, moves, and comments fail with context.Canceled
+// - The cancelled writes never reach the API
+func TestServiceContextCancellation(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	writes := 0
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writes++
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		fmt.Fprintf(w, `{"restUrlPrefix": "%s"}`, server.URL)
+	}))
+	t.Cleanup(server.Close)
+	cfg := &config.Config{AuthKey: "test-key", OrgID: "org-123", UserEmail: "me@example.com", RestDirectoryURL: server.URL, RefreshPrefix: true}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	svc, err := NewTicketService(ctx, cfg, Options{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	cancel()
+
+	t.Run("Given a cancelled context When updating a ticket Then the update is aborted", func(t *testing.T) {
+		if err := svc.UpdateTicket("ticket-1", map[string]any{"name": "Renamed"}); !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("Given a cancelled context When moving a ticket Then the move is aborted", func(t *testing.T) {
+		if err := svc.MoveTicket("ticket-1", "bin-2"); !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("Given a cancelled context When posting a comment Then the comment is aborted", func(t *testing.T) {
+		payload := BuildCommentPayload("comment-1", "ticket-1", "Done")
+		if _, err := svc.PostComment(payload); !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+	})
+
+	if writes != 0 {
+		t.Errorf("Expected no writes to reach the API, got %d", writes)
+	}
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Germanicus1/fb/config"
+)
+
+// TestTrackerRequestStats tests totalling request counters across ticket services
+//
+// Acceptance Criteria:
+// - Each service counts only its own requests
+// - A Tracker passed in the service Options totals the services created with it
+// - Services created without a Tracker are not tracked
+func TestTrackerRequestStats(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/bins" {
+			fmt.Fprint(w, `[]`)
+			return
+		}
+		fmt.Fprintf(w, `{"restUrlPrefix": "%s"}`, server.URL)
+	}))
+	t.Cleanup(server.Close)
+	cfg := &config.Config{AuthKey: "test-key", OrgID: "org-123", UserEmail: "me@example.com", RestDirectoryURL: server.URL, RefreshPrefix: true}
+
+	newService := func(t *testing.T, opts Options) *TicketService {
+		t.Helper()
+		svc, err := NewTicketService(context.Background(), cfg, opts)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if _, err := svc.GetBins(); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		return svc
+	}
+
+	tracker := &Tracker{}
+	first := newService(t, Options{Tracker: tracker})
+	second := newService(t, Options{Tracker: tracker})
+	newService(t, Options{})
+
+	if calls := first.RequestStats().Calls; calls != second.RequestStats().Calls || calls == 0 {
+		t.Fatalf("Expected each service to count its own requests, got %d and %d", calls, second.RequestStats().Calls)
+	}
+	if got, want := tracker.RequestStats().Calls, 2*first.RequestStats().Calls; got != want {
+		t.Errorf("Expected the tracker to total %d calls, got %d", want, got)
+	}
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Germanicus1/fb/config"
+	"github.com/Germanicus1/fb/internal/state"
+)
+
+// TestRestPrefixCache tests reusing the discovered REST prefix across runs
+//
+// Acceptance Criteria:
+// - The first run discovers the prefix and caches it per org
+// - A later run within 24 hours reuses the cached prefix without discovery
+// - A cached prefix older than 24 hours, or RefreshPrefix, triggers discovery again
+// - A corrupt cache file silently falls back to discovery
+func TestRestPrefixCache(t *testing.T) {
+	setup := func(t *testing.T) (*config.Config, *int) {
+		tempDir := t.TempDir()
+		originalHome := os.Getenv("HOME")
+		os.Setenv("HOME", tempDir)
+		t.Cleanup(func() { os.Setenv("HOME", originalHome) })
+
+		discoveries := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			discoveries++
+			fmt.Fprintf(w, `{"restUrlPrefix": "https://api.example.com/org-123"}`)
+		}))
+		t.Cleanup(server.Close)
+
+		cfg := &config.Config{AuthKey: "test-key", OrgID: "org-123", UserEmail: "me@example.com", RestDirectoryURL: server.URL}
+		return cfg, &discoveries
+	}
+
+	t.Run("Given a fresh cache When creating the service twice Then discovery runs once", func(t *testing.T) {
+		cfg, discoveries := setup(t)
+
+		for i := 0; i < 2; i++ {
+			svc, err := NewTicketService(context.Background(), cfg, Options{})
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if prefix := svc.GetClient().RestPrefix(); prefix != "https://api.example.com/org-123" {
+				t.Errorf("Expected the discovered prefix, got %q", prefix)
+			}
+		}
+
+		if *discoveries != 1 {
+			t.Errorf("Expected 1 discovery, got %d", *discoveries)
+		}
+	})
+
+	t.Run("Given an expired cache When creating the service Then the prefix is discovered again", func(t *testing.T) {
+		cfg, discoveries := setup(t)
+		state.SaveRestPrefix(cfg.OrgID, "https://stale.example.com", cfg.RestDirectoryURL, time.Now().Add(-25*time.Hour))
+
+		svc, err := NewTicketService(context.Background(), cfg, Options{})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if *discoveries != 1 || svc.GetClient().RestPrefix() != "https://api.example.com/org-123" {
+			t.Errorf("Expected rediscovery, got %d discoveries and prefix %q", *discoveries, svc.GetClient().RestPrefix())
+		}
+	})
+
+	t.Run("Given RefreshPrefix When creating the service Then the cache is ignored", func(t *testing.T) {
+		cfg, discoveries := setup(t)
+		state.SaveRestPrefix(cfg.OrgID, "https://cached.example.com", cfg.RestDirectoryURL, time.Now())
+		cfg.RefreshPrefix = true
+
+		if _, err := NewTicketService(context.Background(), cfg, Options{}); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if *discoveries != 1 {
+			t.Errorf("Expected 1 discovery, got %d", *discoveries)
+		}
+	})
+
+	t.Run("Given a corrupt cache file When creating the service Then discovery is used without error", func(t *testing.T) {
+		cfg, discoveries := setup(t)
+		home, _ := os.UserHomeDir()
+		os.MkdirAll(filepath.Join(home, ".fb"), 0700)
+		os.WriteFile(filepath.Join(home, ".fb", "rest-prefix-cache.json"), []byte("{not json"), 0600)
+
+		if _, err := NewTicketService(context.Background(), cfg, Options{}); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if *discoveries != 1 {
+			t.Errorf("Expected 1 discovery, got %d", *discoveries)
+		}
+		if cached, err := state.LoadRestPrefix(cfg.OrgID); err != nil || cached.Prefix == "" {
+			t.Errorf("Expected the corrupt cache to be replaced, got %v (err %v)", cached, err)
+		}
+	})
+}
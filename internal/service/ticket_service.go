@@ -3,31 +3,144 @@
 package service
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
 
 	"github.com/Germanicus1/fb/api"
 	"github.com/Germanicus1/fb/config"
+	"github.com/Germanicus1/fb/internal/state"
 	"github.com/Germanicus1/fb/models"
 )
 
 // TicketService handles ticket-related operations
 type TicketService struct {
-	client *api.Client
-	cfg    *config.Config
+	client      *api.Client
+	cfg         *config.Config
+	ctx         context.Context
+	currentUser *models.User // The configured user, once looked up
 }
 
-// NewTicketService creates a new ticket service with an initialized API client
-func NewTicketService(cfg *config.Config) (*TicketService, error) {
+// Options holds the settings of a ticket service that come from the caller rather than the config
+type Options struct {
+	Tracker     *Tracker  // Records the service so the caller can total its requests; nil tracks nothing
+	TraceOutput io.Writer // Receives a trace of API requests and responses with the auth key redacted; nil disables tracing
+}
+
+// Tracker records the ticket services created with it in their Options, so a caller can total
+// the requests of a run. It is safe for concurrent use.
+type Tracker struct {
+	mu       sync.Mutex
+	services []*TicketService
+}
+
+// add records a newly created service
+func (t *Tracker) add(s *TicketService) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.services = append(t.services, s)
+}
+
+// RequestStats totals the requests made by every tracked service
+func (t *Tracker) RequestStats() api.RequestStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var total api.RequestStats
+	for _, s := range t.services {
+		total = total.Add(s.RequestStats())
+	}
+	return total
+}
+
+// NewTicketService creates a new ticket service with an initialized API client. Every request
+// the service makes, reads and writes alike, is sent with ctx, so cancelling ctx aborts it.
+func NewTicketService(ctx context.Context, cfg *config.Config, opts Options) (*TicketService, error) {
 	client := api.NewClient(cfg.AuthKey)
+	if cfg.TimeoutSeconds != nil {
+		client = api.NewClientWithTimeout(cfg.AuthKey, time.Duration(*cfg.TimeoutSeconds)*time.Second)
+	}
+	if cfg.RestDirectoryURL != "" {
+		client.SetRestDirectoryURL(cfg.RestDirectoryURL)
+	}
+	if cfg.PageSize != 0 {
+		client.SetPageSize(cfg.PageSize)
+	}
+	if cfg.RateLimitMaxWaitSeconds != 0 {
+		client.SetMaxRateLimitWait(time.Duration(cfg.RateLimitMaxWaitSeconds) * time.Second)
+	}
+	client.SetPaginationDisabled(cfg.NoPagination)
+	client.SetNetworkDisabled(cfg.NoNetwork)
+	if cfg.Debug {
+		client.SetDebugOutput(os.Stderr)
+	}
+	client.SetTraceOutput(opts.TraceOutput)
 
-	if err := client.DiscoverRestPrefix(cfg.OrgID); err != nil {
+	if err := resolveRestPrefix(ctx, client, cfg); err != nil {
 		return nil, fmt.Errorf("failed to discover API endpoint: %w", err)
 	}
 
-	return &TicketService{
+	ticketService := &TicketService{
 		client: client,
 		cfg:    cfg,
-	}, nil
+		ctx:    ctx,
+	}
+	if opts.Tracker != nil {
+		opts.Tracker.add(ticketService)
+	}
+	return ticketService, nil
+}
+
+// FetchAssignedTickets returns the tickets assigned to the configured user, running the full
+// discover, user lookup, and search sequence against a new service
+func FetchAssignedTickets(ctx context.Context, cfg *config.Config, opts Options) ([]models.Ticket, error) {
+	ticketService, err := NewTicketService(ctx, cfg, opts)
+	if err != nil {
+		return nil, err
+	}
+	return ticketService.FetchAssignedTickets("")
+}
+
+// restPrefixMaxAge is how long a cached REST prefix is reused before it is discovered again
+const restPrefixMaxAge = 24 * time.Hour
+
+// resolveRestPrefix reuses the REST prefix cached for the org when it is recent and came from
+// the same REST directory, and otherwise discovers it and refreshes the cache. An unreadable
+// or corrupt cache falls back to discovery.
+func resolveRestPrefix(ctx context.Context, client *api.Client, cfg *config.Config) error {
+	if !cfg.RefreshPrefix {
+		if cached, err := state.LoadRestPrefix(cfg.OrgID); err == nil && isFreshRestPrefix(cached, client.RestDirectoryURL(), time.Now()) {
+			client.SetRestPrefix(cached.Prefix)
+			return nil
+		}
+	}
+
+	if err := client.DiscoverRestPrefixWithContext(ctx, cfg.OrgID); err != nil {
+		return err
+	}
+
+	// Failing to cache only costs a discovery on the next run
+	state.SaveRestPrefix(cfg.OrgID, client.RestPrefix(), client.RestDirectoryURL(), time.Now())
+	return nil
+}
+
+// isFreshRestPrefix reports whether a cached prefix came from directoryURL less than restPrefixMaxAge ago
+func isFreshRestPrefix(cached *state.RestPrefix, directoryURL string, now time.Time) bool {
+	if cached.Prefix == "" || cached.DirectoryURL != directoryURL {
+		return false
+	}
+	discoveredAt, err := time.Parse(time.RFC3339, cached.DiscoveredAt)
+	if err != nil {
+		return false
+	}
+	return now.Sub(discoveredAt) < restPrefixMaxAge
+}
+
+// RequestStats returns the counters of the requests this service has made
+func (s *TicketService) RequestStats() api.RequestStats {
+	return s.client.Stats()
 }
 
 // GetClient returns the underlying API client
@@ -35,18 +148,58 @@ func (s *TicketService) GetClient() *api.Client {
 	return s.client
 }
 
-// GetCurrentUser retrieves the current user information by email
+// GetCurrentUser retrieves the current user information by email. The configured user's
+// ID is cached between runs, so only its ID and email are set when served from the cache,
+// and is looked up at most once per service.
 func (s *TicketService) GetCurrentUser(email string) (*models.User, error) {
-	user, err := s.client.GetCurrentUser(email)
+	cacheable := email == s.cfg.UserEmail
+	if cacheable && s.currentUser != nil {
+		return s.currentUser, nil
+	}
+	if cacheable && !s.cfg.RefreshUser {
+		if user, ok := s.cachedUser(email); ok {
+			s.currentUser = user
+			return user, nil
+		}
+	}
+
+	user, err := s.client.GetCurrentUserWithContext(s.ctx, email)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user information: %w", err)
 	}
+
+	// Failing to cache only costs a lookup on the next run
+	if cacheable && user.ID != "" {
+		s.currentUser = user
+		state.SaveUserCache(&state.UserCache{Email: email, OrgID: s.cfg.OrgID, UserID: user.ID})
+	}
 	return user, nil
 }
 
+// cachedUser returns the cached user when it was resolved for the same email and org.
+// A missing or corrupt cache falls back to a fresh lookup.
+func (s *TicketService) cachedUser(email string) (*models.User, bool) {
+	cached, err := state.LoadUserCache()
+	if err != nil || cached.UserID == "" || cached.Email != email || cached.OrgID != s.cfg.OrgID {
+		return nil, false
+	}
+	return &models.User{ID: cached.UserID, Email: cached.Email}, true
+}
+
+// FetchAssignedTickets returns the tickets assigned to the configured user, limited to the bin
+// with binID unless it is empty. The list command and the package-level FetchAssignedTickets
+// both fetch the user's own tickets through it.
+func (s *TicketService) FetchAssignedTickets(binID string) ([]models.Ticket, error) {
+	user, err := s.GetCurrentUser(s.cfg.UserEmail)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetTicketsForUsers([]string{user.ID}, binID)
+}
+
 // GetUserTickets retrieves all tickets assigned to the specified user
 func (s *TicketService) GetUserTickets(userID string) ([]models.Ticket, error) {
-	tickets, err := s.client.SearchTickets([]string{userID})
+	tickets, err := s.client.SearchTicketsWithContext(s.ctx, []string{userID})
 	if err != nil {
 		return nil, fmt.Errorf("failed to search tickets: %w", err)
 	}
@@ -55,7 +208,16 @@ func (s *TicketService) GetUserTickets(userID string) ([]models.Ticket, error) {
 
 // GetUserTicketsFiltered retrieves tickets with server-side filtering
 func (s *TicketService) GetUserTicketsFiltered(userID, binID, boardID string) ([]models.Ticket, error) {
-	tickets, err := s.client.SearchTicketsWithFilters([]string{userID}, binID, boardID)
+	tickets, err := s.client.SearchTicketsWithFiltersContext(s.ctx, []string{userID}, binID, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search tickets: %w", err)
+	}
+	return tickets, nil
+}
+
+// GetTicketsForUsers retrieves tickets assigned to any of the given users, with optional bin filtering
+func (s *TicketService) GetTicketsForUsers(userIDs []string, binID string) ([]models.Ticket, error) {
+	tickets, err := s.client.SearchTicketsWithFiltersContext(s.ctx, userIDs, binID, "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to search tickets: %w", err)
 	}
@@ -64,7 +226,7 @@ func (s *TicketService) GetUserTicketsFiltered(userID, binID, boardID string) ([
 
 // GetBins retrieves all bins
 func (s *TicketService) GetBins() ([]models.Bin, error) {
-	bins, err := s.client.GetBins()
+	bins, err := s.client.GetBinsWithContext(s.ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get bins: %w", err)
 	}
@@ -73,9 +235,25 @@ func (s *TicketService) GetBins() ([]models.Bin, error) {
 
 // GetBoards retrieves all boards
 func (s *TicketService) GetBoards() ([]models.Board, error) {
-	boards, err := s.client.GetBoards()
+	boards, err := s.client.GetBoardsWithContext(s.ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get boards: %w", err)
 	}
 	return boards, nil
 }
+
+// UpdateTicket updates the provided fields of a ticket
+func (s *TicketService) UpdateTicket(ticketID string, fields map[string]any) error {
+	if err := s.client.UpdateTicketWithContext(s.ctx, ticketID, fields); err != nil {
+		return fmt.Errorf("failed to update ticket %s: %w", ticketID, err)
+	}
+	return nil
+}
+
+// MoveTicket moves a ticket to the bin with the given ID
+func (s *TicketService) MoveTicket(ticketID, binID string) error {
+	if err := s.client.MoveTicketWithContext(s.ctx, ticketID, binID); err != nil {
+		return fmt.Errorf("failed to move ticket %s: %w", ticketID, err)
+	}
+	return nil
+}
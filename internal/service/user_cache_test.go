@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Germanicus1/fb/config"
+)
+
+// TestUserIDCache tests reusing the configured user's ID across runs
+//
+// Acceptance Criteria:
+// - The first lookup calls the API and caches the user ID for the email and org
+// - A later run with the same email and org reuses the cached ID without a lookup
+// - A different configured email, RefreshUser, or a corrupt cache triggers a fresh lookup
+// - Other users' emails (e.g. --assignee) are always looked up
+func TestUserIDCache(t *testing.T) {
+	setup := func(t *testing.T) (*config.Config, *int, string) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+
+		lookups := 0
+		var server *httptest.Server
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if email, ok := strings.CutPrefix(r.URL.Path, "/users/"); ok {
+				lookups++
+				fmt.Fprintf(w, `{"_id": "id-%s", "email": "%s"}`, email, email)
+				return
+			}
+			fmt.Fprintf(w, `{"restUrlPrefix": "%s"}`, server.URL)
+		}))
+		t.Cleanup(server.Close)
+
+		cfg := &config.Config{AuthKey: "test-key", OrgID: "org-123", UserEmail: "me@example.com", RestDirectoryURL: server.URL}
+		return cfg, &lookups, home
+	}
+
+	lookup := func(t *testing.T, cfg *config.Config, email string) string {
+		t.Helper()
+		svc, err := NewTicketService(context.Background(), cfg, Options{})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		user, err := svc.GetCurrentUser(email)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		return user.ID
+	}
+
+	t.Run("Given a cached user ID When running twice Then the user is looked up once", func(t *testing.T) {
+		cfg, lookups, _ := setup(t)
+
+		for i := 0; i < 2; i++ {
+			if id := lookup(t, cfg, cfg.UserEmail); id != "id-me@example.com" {
+				t.Errorf("Expected the user ID, got %q", id)
+			}
+		}
+
+		if *lookups != 1 {
+			t.Errorf("Expected 1 lookup, got %d", *lookups)
+		}
+	})
+
+	t.Run("Given a changed email When running Then the user is looked up again", func(t *testing.T) {
+		cfg, lookups, _ := setup(t)
+		lookup(t, cfg, cfg.UserEmail)
+
+		cfg.UserEmail = "other@example.com"
+		if id := lookup(t, cfg, cfg.UserEmail); id != "id-other@example.com" {
+			t.Errorf("Expected the new user's ID, got %q", id)
+		}
+
+		if *lookups != 2 {
+			t.Errorf("Expected 2 lookups, got %d", *lookups)
+		}
+	})
+
+	t.Run("Given RefreshUser When running Then the cache is bypassed", func(t *testing.T) {
+		cfg, lookups, _ := setup(t)
+		lookup(t, cfg, cfg.UserEmail)
+
+		cfg.RefreshUser = true
+		lookup(t, cfg, cfg.UserEmail)
+
+		if *lookups != 2 {
+			t.Errorf("Expected 2 lookups, got %d", *lookups)
+		}
+	})
+
+	t.Run("Given a corrupt cache When running Then the user is looked up without failing", func(t *testing.T) {
+		cfg, lookups, home := setup(t)
+		os.MkdirAll(filepath.Join(home, ".fb"), 0700)
+		os.WriteFile(filepath.Join(home, ".fb", "user-cache.json"), []byte("{not json"), 0600)
+
+		if id := lookup(t, cfg, cfg.UserEmail); id != "id-me@example.com" {
+			t.Errorf("Expected the user ID, got %q", id)
+		}
+		if *lookups != 1 {
+			t.Errorf("Expected 1 lookup, got %d", *lookups)
+		}
+	})
+
+	t.Run("Given another user's email When looking up twice Then both calls hit the API", func(t *testing.T) {
+		cfg, lookups, _ := setup(t)
+
+		lookup(t, cfg, "teammate@example.com")
+		lookup(t, cfg, "teammate@example.com")
+
+		if *lookups != 2 {
+			t.Errorf("Expected 2 lookups, got %d", *lookups)
+		}
+	})
+}
@@ -4,13 +4,16 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+
+	"github.com/Germanicus1/fb/config"
 )
 
 // SaveBinContext saves the last used bin context to ~/.fb/bin_context.json
 func SaveBinContext(binID, binName string) error {
-	homeDir, _ := os.UserHomeDir()
-	fbDir := filepath.Join(homeDir, ".fb")
-	os.MkdirAll(fbDir, 0700)
+	fbDir, err := ensureStateDir()
+	if err != nil {
+		return err
+	}
 
 	context := BinContext{
 		BinID:   binID,
@@ -23,13 +26,16 @@ func SaveBinContext(binID, binName string) error {
 	}
 
 	contextPath := filepath.Join(fbDir, "bin_context.json")
-	return os.WriteFile(contextPath, data, 0600)
+	return writeFileAtomic(contextPath, data, 0600)
 }
 
 // LoadBinContext loads the last used bin context from ~/.fb/bin_context.json
 func LoadBinContext() (*BinContext, error) {
-	homeDir, _ := os.UserHomeDir()
-	contextPath := filepath.Join(homeDir, ".fb", "bin_context.json")
+	dir, err := config.ConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	contextPath := filepath.Join(dir, "bin_context.json")
 
 	data, err := os.ReadFile(contextPath)
 	if err != nil {
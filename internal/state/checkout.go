@@ -5,13 +5,16 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+
+	"github.com/Germanicus1/fb/config"
 )
 
 // SaveCheckout saves the checkout state to ~/.fb/checkout.json
 func SaveCheckout(checkout *CheckoutState) error {
-	homeDir, _ := os.UserHomeDir()
-	fbDir := filepath.Join(homeDir, ".fb")
-	os.MkdirAll(fbDir, 0700)
+	fbDir, err := ensureStateDir()
+	if err != nil {
+		return err
+	}
 
 	data, err := json.MarshalIndent(checkout, "", "  ")
 	if err != nil {
@@ -19,12 +22,15 @@ func SaveCheckout(checkout *CheckoutState) error {
 	}
 
 	checkoutPath := filepath.Join(fbDir, "checkout.json")
-	return os.WriteFile(checkoutPath, data, 0600)
+	return writeFileAtomic(checkoutPath, data, 0600)
 }
 
 // ClearCheckout removes the checkout state file
 func ClearCheckout() error {
-	checkoutPath := getCheckoutFilePath()
+	checkoutPath, err := getCheckoutFilePath()
+	if err != nil {
+		return err
+	}
 	if err := os.Remove(checkoutPath); err != nil && !os.IsNotExist(err) {
 		return fmt.Errorf("failed to clear checkout: %w", err)
 	}
@@ -33,7 +39,10 @@ func ClearCheckout() error {
 
 // LoadCheckout loads the checkout state from ~/.fb/checkout.json
 func LoadCheckout() (*CheckoutState, error) {
-	checkoutPath := getCheckoutFilePath()
+	checkoutPath, err := getCheckoutFilePath()
+	if err != nil {
+		return nil, err
+	}
 	data, err := os.ReadFile(checkoutPath)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -51,7 +60,10 @@ func LoadCheckout() (*CheckoutState, error) {
 }
 
 // getCheckoutFilePath returns the path to the checkout state file
-func getCheckoutFilePath() string {
-	homeDir, _ := os.UserHomeDir()
-	return filepath.Join(homeDir, ".fb", "checkout.json")
+func getCheckoutFilePath() (string, error) {
+	dir, err := config.ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "checkout.json"), nil
 }
@@ -0,0 +1,73 @@
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Germanicus1/fb/config"
+)
+
+// SaveRestPrefix caches the REST prefix discovered for an organization in
+// ~/.fb/rest-prefix-cache.json, keeping the entries of other organizations
+func SaveRestPrefix(orgID, prefix, directoryURL string, discoveredAt time.Time) error {
+	fbDir, err := ensureStateDir()
+	if err != nil {
+		return err
+	}
+
+	// A corrupt cache is simply replaced
+	cache, _ := loadRestPrefixCache()
+	if cache == nil {
+		cache = map[string]RestPrefix{}
+	}
+	cache[orgID] = RestPrefix{
+		Prefix:       prefix,
+		DirectoryURL: directoryURL,
+		DiscoveredAt: discoveredAt.Format(time.RFC3339),
+	}
+
+	data, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(filepath.Join(fbDir, "rest-prefix-cache.json"), data, 0600)
+}
+
+// LoadRestPrefix loads the cached REST prefix for an organization from ~/.fb/rest-prefix-cache.json
+func LoadRestPrefix(orgID string) (*RestPrefix, error) {
+	cache, err := loadRestPrefixCache()
+	if err != nil {
+		return nil, err
+	}
+
+	entry, ok := cache[orgID]
+	if !ok {
+		return nil, fmt.Errorf("no cached REST prefix for org %s", orgID)
+	}
+	return &entry, nil
+}
+
+// loadRestPrefixCache reads every cached REST prefix, keyed by org ID
+func loadRestPrefixCache() (map[string]RestPrefix, error) {
+	dir, err := config.ConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "rest-prefix-cache.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no REST prefix cache found")
+		}
+		return nil, fmt.Errorf("failed to read REST prefix cache: %w", err)
+	}
+
+	var cache map[string]RestPrefix
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil, fmt.Errorf("failed to parse REST prefix cache: %w", err)
+	}
+	return cache, nil
+}
@@ -1,4 +1,50 @@
 package state
 
-// Placeholder for state management code
-// Will be populated in Phase 2
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/Germanicus1/fb/config"
+)
+
+// ensureStateDir creates the directory holding the state files and returns it. State lives
+// beside config.yaml, so FB_CONFIG_DIR also locates it when the home directory is unknown.
+func ensureStateDir() (string, error) {
+	dir, err := config.ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+// writeFileAtomic writes data to a temporary file in the same directory and renames it
+// into place, so an interrupted write never leaves a truncated state file behind
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Chmod(tmpPath, perm); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Germanicus1/fb/config"
+)
+
+// SaveTicketCount caches the number of assigned tickets to ~/.fb/ticket_count.json
+func SaveTicketCount(count int, fetchedAt time.Time) error {
+	fbDir, err := ensureStateDir()
+	if err != nil {
+		return err
+	}
+
+	cached := TicketCount{
+		Count:     count,
+		FetchedAt: fetchedAt.Format(time.RFC3339),
+	}
+
+	data, err := json.MarshalIndent(cached, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(filepath.Join(fbDir, "ticket_count.json"), data, 0600)
+}
+
+// LoadTicketCount loads the cached assigned-ticket count from ~/.fb/ticket_count.json
+func LoadTicketCount() (*TicketCount, error) {
+	dir, err := config.ConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "ticket_count.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no cached ticket count found")
+		}
+		return nil, fmt.Errorf("failed to read ticket count cache: %w", err)
+	}
+
+	var cached TicketCount
+	if err := json.Unmarshal(data, &cached); err != nil {
+		return nil, fmt.Errorf("failed to parse ticket count cache: %w", err)
+	}
+
+	return &cached, nil
+}
@@ -16,3 +16,23 @@ type BinContext struct {
 	BinID   string `json:"bin_id"`
 	BinName string `json:"bin_name"`
 }
+
+// TicketCount represents the cached number of assigned tickets from the last fetch
+type TicketCount struct {
+	Count     int    `json:"count"`
+	FetchedAt string `json:"fetched_at"`
+}
+
+// UserCache represents the user ID resolved for the configured email in one organization
+type UserCache struct {
+	Email  string `json:"email"`
+	OrgID  string `json:"org_id"`
+	UserID string `json:"user_id"`
+}
+
+// RestPrefix represents a discovered REST prefix cached for one organization
+type RestPrefix struct {
+	Prefix       string `json:"prefix"`
+	DirectoryURL string `json:"directory_url"`
+	DiscoveredAt string `json:"discovered_at"`
+}
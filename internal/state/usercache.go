@@ -0,0 +1,46 @@
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Germanicus1/fb/config"
+)
+
+// SaveUserCache caches the user ID resolved for the configured email in ~/.fb/user-cache.json
+func SaveUserCache(cache *UserCache) error {
+	fbDir, err := ensureStateDir()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(filepath.Join(fbDir, "user-cache.json"), data, 0600)
+}
+
+// LoadUserCache loads the cached user ID from ~/.fb/user-cache.json
+func LoadUserCache() (*UserCache, error) {
+	dir, err := config.ConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "user-cache.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no user cache found")
+		}
+		return nil, fmt.Errorf("failed to read user cache: %w", err)
+	}
+
+	var cache UserCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil, fmt.Errorf("failed to parse user cache: %w", err)
+	}
+	return &cache, nil
+}
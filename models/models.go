@@ -34,6 +34,8 @@ type Ticket struct {
 	Description string    `json:"description"`
 	BinID       string    `json:"bin_id"`
 	BinName     string    `json:"bin_name"`
+	BoardID     string    `json:"board_id,omitempty"`
+	BoardName   string    `json:"board_name,omitempty"`
 	CreatedAt   time.Time `json:"createdAt,omitempty"`
 	UpdatedAt   time.Time `json:"updatedAt,omitempty"`
 	DueDate     time.Time `json:"dueDate,omitempty"`
@@ -76,6 +78,63 @@ func (t Ticket) FormattedDueDate() string {
 	return formatDate(t.DueDate)
 }
 
+// IsDueWithin returns true if the ticket's due date falls between now and now+window.
+// Tickets without a due date or whose due date has already passed are not due within the window.
+func (t Ticket) IsDueWithin(now time.Time, window time.Duration) bool {
+	if t.DueDate.IsZero() || t.DueDate.Before(now) {
+		return false
+	}
+	return !t.DueDate.After(now.Add(window))
+}
+
+// IsOverdue returns true if the ticket's due date is on a day before today.
+// A ticket due today is not overdue.
+func (t Ticket) IsOverdue() bool {
+	return t.IsOverdueAt(time.Now())
+}
+
+// IsOverdueAt returns true if the ticket's due date is on a day before now's day.
+// The comparison is date-only, so a ticket due today is not overdue.
+func (t Ticket) IsOverdueAt(now time.Time) bool {
+	if t.DueDate.IsZero() {
+		return false
+	}
+	return dateOnly(t.DueDate).Before(dateOnly(now))
+}
+
+// DaysUntilDue returns the signed number of calendar days from today to the due date:
+// positive when due in the future, negative when past, and zero when due today or unset.
+func (t Ticket) DaysUntilDue() int {
+	return t.DaysUntilDueAt(time.Now())
+}
+
+// DaysUntilDueAt returns the signed number of calendar days from now's day to the due date.
+func (t Ticket) DaysUntilDueAt(now time.Time) int {
+	if t.DueDate.IsZero() {
+		return 0
+	}
+	return int(dateOnly(t.DueDate).Sub(dateOnly(now)).Hours() / 24)
+}
+
+// AgeDays returns the number of calendar days since the ticket was created: zero when
+// created today or when the created date is unset.
+func (t Ticket) AgeDays() int {
+	return t.AgeDaysAt(time.Now())
+}
+
+// AgeDaysAt returns the number of calendar days from the created date to now's day.
+func (t Ticket) AgeDaysAt(now time.Time) int {
+	if t.CreatedAt.IsZero() {
+		return 0
+	}
+	return int(dateOnly(now).Sub(dateOnly(t.CreatedAt)).Hours() / 24)
+}
+
+// dateOnly strips the time of day, keeping the calendar date
+func dateOnly(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
+
 // formatDate converts a time.Time to YYYY-MM-DD format.
 // Returns empty string if the date is zero.
 func formatDate(date time.Time) string {